@@ -0,0 +1,461 @@
+package DSP
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/luigifreitas/radioserver/protocol"
+	"github.com/racerxdl/segdsp/dsp"
+	"github.com/racerxdl/segdsp/dsp/fft"
+)
+
+const (
+	defaultFFTSize      = 1024
+	defaultFMDeviation  = 5000
+	squelchAlpha        = 0.01
+	amDCAlpha           = 0.001
+	transitionBandRatio = 0.2
+)
+
+func decimationOf(c *protocol.BlockConfig) int {
+	if c.Decimation < 1 {
+		return 1
+	}
+	return int(c.Decimation)
+}
+
+func expectKind(in protocol.SampleKind, accepted ...protocol.SampleKind) error {
+	for _, k := range accepted {
+		if in == k {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s input not supported", in)
+}
+
+// lowPassParams returns the cutoff and transition width for a low pass of c.Bandwidth
+func lowPassParams(c *protocol.BlockConfig, cutoff, sampleRate float64) (float64, float64, error) {
+	if cutoff <= 0 || cutoff >= sampleRate/2 {
+		return 0, 0, fmt.Errorf("bandwidth %v outside of the input band", c.Bandwidth)
+	}
+
+	transition := float64(c.Transition)
+	if transition <= 0 {
+		transition = cutoff * transitionBandRatio
+	}
+
+	return cutoff, transition, nil
+}
+
+// region Translator
+
+// translatorBlock moves Frequency to the center, filters Bandwidth around it and decimates
+type translatorBlock struct {
+	translator *dsp.FrequencyTranslator
+	params     [4]float64
+}
+
+func (b *translatorBlock) Configure(c *protocol.BlockConfig, in protocol.SampleKind, sampleRate float64) (protocol.SampleKind, float64, error) {
+	if err := expectKind(in, protocol.SampleKind_IQSamples); err != nil {
+		return in, 0, err
+	}
+
+	decimation := decimationOf(c)
+	if math.Abs(float64(c.Frequency)) > sampleRate/2 {
+		return in, 0, fmt.Errorf("frequency %v outside of the input band", c.Frequency)
+	}
+
+	bandwidth := float64(c.Bandwidth)
+	if bandwidth == 0 {
+		bandwidth = sampleRate / float64(decimation)
+	}
+
+	cutoff, transition, err := lowPassParams(c, bandwidth/2, sampleRate)
+	if err != nil {
+		return in, 0, err
+	}
+
+	// segdsp selects the signal at minus its center frequency
+	params := [4]float64{sampleRate, float64(decimation), cutoff, transition}
+	if b.translator != nil && params == b.params {
+		b.translator.SetFrequency(-c.Frequency)
+	} else {
+		taps := dsp.MakeLowPass(1, sampleRate, cutoff, transition)
+		b.translator = dsp.MakeFrequencyTranslator(decimation, -c.Frequency, float32(sampleRate), taps)
+		b.params = params
+	}
+
+	return in, sampleRate / float64(decimation), nil
+}
+
+func (b *translatorBlock) Work(in Buffer) Buffer {
+	in.Complex = b.translator.Work(in.Complex)
+	return in
+}
+
+// endregion
+// region Decimator
+
+type decimatorBlock struct {
+	decimator  *Resampler
+	decimation int
+}
+
+func (b *decimatorBlock) Configure(c *protocol.BlockConfig, in protocol.SampleKind, sampleRate float64) (protocol.SampleKind, float64, error) {
+	if err := expectKind(in, protocol.SampleKind_IQSamples, protocol.SampleKind_AudioSamples); err != nil {
+		return in, 0, err
+	}
+
+	decimation := decimationOf(c)
+	if decimation != b.decimation || b.decimator == nil {
+		b.decimator = MakeResampler(1, decimation)
+		b.decimation = decimation
+	}
+
+	return in, sampleRate / float64(decimation), nil
+}
+
+func (b *decimatorBlock) Work(in Buffer) Buffer {
+	if in.Kind == protocol.SampleKind_IQSamples {
+		in.Complex = b.decimator.Work(in.Complex)
+	} else {
+		in.Real = b.decimator.WorkFloat(in.Real)
+	}
+	return in
+}
+
+// endregion
+// region Resampler
+
+// resamplerBlock converts to Rate exactly, or by Interpolation / Decimation when Rate is not set
+type resamplerBlock struct {
+	resampler     *Resampler
+	interpolation int
+	decimation    int
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func (b *resamplerBlock) Configure(c *protocol.BlockConfig, in protocol.SampleKind, sampleRate float64) (protocol.SampleKind, float64, error) {
+	if err := expectKind(in, protocol.SampleKind_IQSamples, protocol.SampleKind_AudioSamples); err != nil {
+		return in, 0, err
+	}
+
+	var interpolation, decimation int
+	if c.Rate > 0 {
+		var err error
+		interpolation, decimation, err = RationalRatio(sampleRate, c.Rate)
+		if err != nil {
+			return in, 0, err
+		}
+	} else {
+		interpolation = int(c.Interpolation)
+		if interpolation < 1 {
+			interpolation = 1
+		}
+		decimation = decimationOf(c)
+		d := gcd(interpolation, decimation)
+		interpolation, decimation = interpolation/d, decimation/d
+		if interpolation > maxInterpolation {
+			return in, 0, fmt.Errorf("interpolation %d over the maximum of %d", interpolation, maxInterpolation)
+		}
+	}
+
+	if b.resampler == nil || interpolation != b.interpolation || decimation != b.decimation {
+		b.resampler = MakeResampler(interpolation, decimation)
+		b.interpolation = interpolation
+		b.decimation = decimation
+	}
+
+	return in, sampleRate * float64(interpolation) / float64(decimation), nil
+}
+
+func (b *resamplerBlock) Work(in Buffer) Buffer {
+	if in.Kind == protocol.SampleKind_IQSamples {
+		in.Complex = b.resampler.Work(in.Complex)
+	} else {
+		in.Real = b.resampler.WorkFloat(in.Real)
+	}
+	return in
+}
+
+// endregion
+// region Filter
+
+// filterBlock is a low pass of Bandwidth, the total width for IQ and the cutoff for audio
+type filterBlock struct {
+	filter  *dsp.FirFilter
+	fFilter *dsp.FloatFirFilter
+	kind    protocol.SampleKind
+	params  [3]float64
+}
+
+func (b *filterBlock) Configure(c *protocol.BlockConfig, in protocol.SampleKind, sampleRate float64) (protocol.SampleKind, float64, error) {
+	if err := expectKind(in, protocol.SampleKind_IQSamples, protocol.SampleKind_AudioSamples); err != nil {
+		return in, 0, err
+	}
+
+	cutoff := float64(c.Bandwidth)
+	if in == protocol.SampleKind_IQSamples {
+		cutoff /= 2
+	}
+
+	cutoff, transition, err := lowPassParams(c, cutoff, sampleRate)
+	if err != nil {
+		return in, 0, err
+	}
+
+	params := [3]float64{sampleRate, cutoff, transition}
+	if b.filter == nil || b.kind != in || params != b.params {
+		taps := dsp.MakeLowPass(1, sampleRate, cutoff, transition)
+		b.filter = dsp.MakeFirFilter(taps)
+		b.fFilter = dsp.MakeFloatFirFilter(taps)
+		b.kind = in
+		b.params = params
+	}
+
+	return in, sampleRate, nil
+}
+
+func (b *filterBlock) Work(in Buffer) Buffer {
+	if in.Kind == protocol.SampleKind_IQSamples {
+		in.Complex = b.filter.Work(in.Complex)
+	} else {
+		in.Real = b.fFilter.Work(in.Real)
+	}
+	return in
+}
+
+// endregion
+// region Demodulator
+
+// demodBlock turns IQ into audio, FM scaled so that Deviation is full scale
+type demodBlock struct {
+	mode     protocol.DemodMode
+	fm       *dsp.QuadDemod
+	fmGain   float32
+	am       *dsp.Complex2Magnitude
+	amDC     *dsp.SinglePoleIIRFilter
+	amPrimed bool
+}
+
+func (b *demodBlock) Configure(c *protocol.BlockConfig, in protocol.SampleKind, sampleRate float64) (protocol.SampleKind, float64, error) {
+	if err := expectKind(in, protocol.SampleKind_IQSamples); err != nil {
+		return in, 0, err
+	}
+
+	switch c.Mode {
+	case protocol.DemodMode_FMDemod:
+		deviation := float64(c.Deviation)
+		if deviation == 0 {
+			deviation = defaultFMDeviation
+		}
+		if deviation < 0 || deviation >= sampleRate/2 {
+			return in, 0, fmt.Errorf("deviation %v outside of the input band", c.Deviation)
+		}
+		gain := float32(sampleRate / (2 * math.Pi * deviation))
+		if b.fm == nil || gain != b.fmGain {
+			b.fm = dsp.MakeQuadDemod(gain)
+			b.fmGain = gain
+		}
+	case protocol.DemodMode_AMDemod:
+		if b.am == nil {
+			b.am = dsp.MakeComplex2Magnitude()
+			b.amDC = dsp.MakeSinglePoleIIRFilter(amDCAlpha)
+			b.amPrimed = false
+		}
+	default:
+		return in, 0, fmt.Errorf("unknown demodulation mode %s", c.Mode)
+	}
+
+	b.mode = c.Mode
+	return protocol.SampleKind_AudioSamples, sampleRate, nil
+}
+
+func (b *demodBlock) Work(in Buffer) Buffer {
+	out := Buffer{
+		Kind: protocol.SampleKind_AudioSamples,
+	}
+
+	if b.mode == protocol.DemodMode_FMDemod {
+		out.Real = b.fm.Work(in.Complex)
+		return out
+	}
+
+	out.Real = b.am.Work(in.Complex)
+	if !b.amPrimed && len(out.Real) > 0 {
+		// Start the carrier estimate at the first sample instead of ramping from zero
+		for i := 0; i < 1/amDCAlpha; i++ {
+			b.amDC.Filter(out.Real[0])
+		}
+		b.amPrimed = true
+	}
+	for i, v := range out.Real {
+		out.Real[i] = v - b.amDC.Filter(v)
+	}
+
+	return out
+}
+
+// endregion
+// region FFT
+
+// fftBlock outputs power spectra of FFTSize bins in dB, averaging Decimation frames each.
+// The bins are ordered from the lowest to the highest frequency.
+type fftBlock struct {
+	size     int
+	averages int
+	window   []float32
+	pending  []complex64
+	power    []float64
+	frames   int
+}
+
+func (b *fftBlock) Configure(c *protocol.BlockConfig, in protocol.SampleKind, sampleRate float64) (protocol.SampleKind, float64, error) {
+	if err := expectKind(in, protocol.SampleKind_IQSamples); err != nil {
+		return in, 0, err
+	}
+
+	size := int(c.FFTSize)
+	if size == 0 {
+		size = defaultFFTSize
+	}
+	if size < 2 {
+		return in, 0, fmt.Errorf("invalid fft size %d", size)
+	}
+
+	averages := decimationOf(c)
+	if size != b.size || averages != b.averages {
+		b.size = size
+		b.averages = averages
+		b.window = spectrumWindow(size)
+		b.pending = nil
+		b.power = make([]float64, size)
+		b.frames = 0
+	}
+
+	return protocol.SampleKind_SpectrumSamples, sampleRate / float64(averages), nil
+}
+
+func spectrumWindow(size int) []float32 {
+	w := dsp.BlackmanHarris(size, 92)
+	window := make([]float32, size)
+	sum := 0.0
+	for _, v := range w {
+		sum += v
+	}
+	// Normalized so that a full scale tone reads 0 dB
+	for i, v := range w {
+		window[i] = float32(v / sum)
+	}
+	return window
+}
+
+func (b *fftBlock) Work(in Buffer) Buffer {
+	out := Buffer{
+		Kind: protocol.SampleKind_SpectrumSamples,
+	}
+
+	b.pending = append(b.pending, in.Complex...)
+	frame := make([]complex64, b.size)
+
+	for len(b.pending) >= b.size {
+		for i := range frame {
+			frame[i] = b.pending[i] * complex(b.window[i], 0)
+		}
+		b.pending = b.pending[b.size:]
+
+		for i, v := range fft.FFT(frame) {
+			b.power[i] += float64(real(v)*real(v) + imag(v)*imag(v))
+		}
+
+		b.frames++
+		if b.frames < b.averages {
+			continue
+		}
+
+		half := b.size / 2
+		for i := range b.power {
+			// Shift the negative frequencies to the start
+			p := b.power[(i+b.size-half)%b.size] / float64(b.frames)
+			out.Real = append(out.Real, float32(10*math.Log10(p+1e-20)))
+		}
+
+		for i := range b.power {
+			b.power[i] = 0
+		}
+		b.frames = 0
+	}
+
+	// Keep the pending samples off the caller buffer
+	b.pending = append([]complex64(nil), b.pending...)
+
+	return out
+}
+
+// endregion
+// region Squelch
+
+// squelchBlock drops the IQ samples while their average power is under Threshold dB,
+// with a Hysteresis in dB and a HangTime in seconds
+type squelchBlock struct {
+	squelch *PowerSquelch
+	events  []SquelchEvent
+}
+
+func (b *squelchBlock) Configure(c *protocol.BlockConfig, in protocol.SampleKind, sampleRate float64) (protocol.SampleKind, float64, error) {
+	if err := expectKind(in, protocol.SampleKind_IQSamples); err != nil {
+		return in, 0, err
+	}
+
+	if c.HangTime < 0 {
+		return in, 0, fmt.Errorf("invalid hang time %v", c.HangTime)
+	}
+
+	if b.squelch == nil {
+		b.squelch = MakePowerSquelch()
+	}
+	b.squelch.Configure(float64(c.Threshold), float64(c.Hysteresis), float64(c.HangTime), sampleRate)
+
+	return in, sampleRate, nil
+}
+
+func (b *squelchBlock) Work(in Buffer) Buffer {
+	var events []SquelchEvent
+	in.Complex, events = b.squelch.Work(in.Complex)
+	b.events = append(b.events, events...)
+	return in
+}
+
+// gated returns the samples of the last buffer held back before and after the ones passed
+func (b *squelchBlock) gated() (leading, trailing int) {
+	return b.squelch.Gated()
+}
+
+// takeEvents returns the events since the last call
+func (b *squelchBlock) takeEvents() []SquelchEvent {
+	events := b.events
+	b.events = nil
+	return events
+}
+
+// endregion
+// region Sink
+
+// sinkBlock delivers its input as a chain output, the Chain handles it
+type sinkBlock struct{}
+
+func (b *sinkBlock) Configure(c *protocol.BlockConfig, in protocol.SampleKind, sampleRate float64) (protocol.SampleKind, float64, error) {
+	return in, sampleRate, nil
+}
+
+func (b *sinkBlock) Work(in Buffer) Buffer {
+	return in
+}
+
+// endregion
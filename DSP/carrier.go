@@ -0,0 +1,79 @@
+package DSP
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/racerxdl/segdsp/dsp/fft"
+)
+
+const (
+	// carrierFFTSize is the largest FFT used to find a carrier, giving sub Hertz bins at low sample rates
+	carrierFFTSize = 1 << 16
+
+	// minCarrierFFTSize is the shortest input accepted to find a carrier
+	minCarrierFFTSize = 1024
+)
+
+// EstimateCarrierOffset finds the strongest carrier within span Hertz of expected, both relative to the
+// center of the samples. It returns the carrier offset and its power over the median of the spectrum, in dB.
+func EstimateCarrierOffset(samples []complex64, sampleRate, expected, span float64) (float64, float64, error) {
+	size := carrierFFTSize
+	for size > len(samples) && size > minCarrierFFTSize {
+		size /= 2
+	}
+	if len(samples) < size {
+		return 0, 0, fmt.Errorf("not enough samples, need at least %d", size)
+	}
+
+	window := spectrumWindow(size)
+	power := make([]float64, size)
+	frame := make([]complex64, size)
+
+	for o := 0; o+size <= len(samples); o += size {
+		for i := range frame {
+			frame[i] = samples[o+i] * complex(window[i], 0)
+		}
+		for i, v := range fft.FFT(frame) {
+			power[i] += float64(real(v)*real(v) + imag(v)*imag(v))
+		}
+	}
+
+	resolution := sampleRate / float64(size)
+	half := size / 2
+
+	// Frequency indexes go from -size/2 to size/2-1, bin maps them to the FFT output
+	bin := func(k int) int {
+		return (k + size) % size
+	}
+
+	first := int(math.Max(math.Ceil((expected-span)/resolution), float64(-half)))
+	last := int(math.Min(math.Floor((expected+span)/resolution), float64(half-1)))
+	if first > last {
+		return 0, 0, fmt.Errorf("search range is outside of the band")
+	}
+
+	peak := first
+	for k := first; k <= last; k++ {
+		if power[bin(k)] > power[bin(peak)] {
+			peak = k
+		}
+	}
+
+	// Gaussian interpolation between the neighbours of the peak, which fits the window main lobe
+	level := func(k int) float64 {
+		return math.Log(power[bin(k)] + 1e-30)
+	}
+	a, b, c := level(peak-1), level(peak), level(peak+1)
+	delta := 0.0
+	if d := a - 2*b + c; d < 0 {
+		delta = 0.5 * (a - c) / d
+	}
+
+	sorted := append([]float64(nil), power...)
+	sort.Float64s(sorted)
+	median := sorted[len(sorted)/2] + 1e-30
+
+	return (float64(peak) + delta) * resolution, 10 * math.Log10(power[bin(peak)]/median), nil
+}
@@ -0,0 +1,303 @@
+package DSP
+
+import (
+	"fmt"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+// Buffer is a block of samples flowing between the blocks of a Chain.
+// Complex holds IQ samples, Real holds audio samples or spectrum bins in dB.
+type Buffer struct {
+	Kind    protocol.SampleKind
+	Complex []complex64
+	Real    []float32
+}
+
+// Len returns the number of samples in the buffer
+func (b Buffer) Len() int {
+	if b.Kind == protocol.SampleKind_IQSamples {
+		return len(b.Complex)
+	}
+	return len(b.Real)
+}
+
+// Output is a buffer delivered by one of the sinks of a Chain
+type Output struct {
+	Buffer
+
+	// Sink is the position of the sink in the chain outputs
+	Sink       uint32
+	SampleRate float64
+
+	// Index is the position of the first sample in the sink output, counting the dropped ones
+	Index uint64
+
+	// FrontendDropped is the total of input samples dropped before the chain so far, in sink samples
+	FrontendDropped uint64
+
+	// Squelched is the total of sink samples held back by a squelch so far
+	Squelched uint64
+
+	// Gated is the number of sink samples held back by a squelch since the previous output of the sink,
+	// to be counted before this one
+	Gated uint64
+
+	// Gain is the digital gain applied to the samples, in dB
+	Gain float32
+}
+
+// ProcessingBlock is a step of a Chain
+type ProcessingBlock interface {
+	// Configure applies c to the block for an input of kind in at sampleRate.
+	// It returns the kind and rate of the output, keeping the block state when possible.
+	Configure(c *protocol.BlockConfig, in protocol.SampleKind, sampleRate float64) (protocol.SampleKind, float64, error)
+
+	// Work processes a buffer, the result can be empty while the block accumulates or mutes its input
+	Work(in Buffer) Buffer
+}
+
+var blockConstructors = map[protocol.BlockType]func() ProcessingBlock{
+	protocol.BlockType_TranslatorBlock:  func() ProcessingBlock { return &translatorBlock{} },
+	protocol.BlockType_DecimatorBlock:   func() ProcessingBlock { return &decimatorBlock{} },
+	protocol.BlockType_ResamplerBlock:   func() ProcessingBlock { return &resamplerBlock{} },
+	protocol.BlockType_FilterBlock:      func() ProcessingBlock { return &filterBlock{} },
+	protocol.BlockType_DemodulatorBlock: func() ProcessingBlock { return &demodBlock{} },
+	protocol.BlockType_FFTBlock:         func() ProcessingBlock { return &fftBlock{} },
+	protocol.BlockType_SquelchBlock:     func() ProcessingBlock { return &squelchBlock{} },
+	protocol.BlockType_SinkBlock:        func() ProcessingBlock { return &sinkBlock{} },
+}
+
+type chainStage struct {
+	kind  protocol.BlockType
+	block ProcessingBlock
+	// rate is the output rate of the block
+	rate float64
+}
+
+// Chain runs IQ samples through a list of blocks.
+// Every sink block delivers its input as an output of the chain, and so does the end of the chain
+// when the last block is not a sink. An empty chain is a passthrough.
+type Chain struct {
+	stages  []chainStage
+	configs []*protocol.BlockConfig
+
+	sampleRate float64
+	sinkRates  []float64
+	sinkKinds  []protocol.SampleKind
+
+	// gated holds the samples held back by a squelch for each sink, not counted by an output yet
+	gated []float64
+}
+
+// MakeChain builds a chain from its declaration for an IQ input at sampleRate
+func MakeChain(configs []*protocol.BlockConfig, sampleRate float64) (*Chain, error) {
+	c := &Chain{}
+	if err := c.build(configs, sampleRate); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Chain) build(configs []*protocol.BlockConfig, sampleRate float64) error {
+	stages := make([]chainStage, len(configs))
+	for i, bc := range configs {
+		if bc == nil {
+			return fmt.Errorf("block %d: missing configuration", i)
+		}
+		constructor := blockConstructors[bc.Type]
+		if constructor == nil {
+			return fmt.Errorf("block %d: unknown type %s", i, bc.Type)
+		}
+		stages[i] = chainStage{
+			kind:  bc.Type,
+			block: constructor(),
+		}
+	}
+
+	sinkRates, sinkKinds, err := configureStages(stages, configs, sampleRate)
+	if err != nil {
+		return err
+	}
+
+	c.stages = stages
+	c.configs = configs
+	c.sampleRate = sampleRate
+	c.sinkRates = sinkRates
+	c.sinkKinds = sinkKinds
+	c.gated = make([]float64, len(sinkRates))
+
+	return nil
+}
+
+func configureStages(stages []chainStage, configs []*protocol.BlockConfig, sampleRate float64) ([]float64, []protocol.SampleKind, error) {
+	var sinkRates []float64
+	var sinkKinds []protocol.SampleKind
+
+	kind := protocol.SampleKind_IQSamples
+	rate := sampleRate
+
+	for i, s := range stages {
+		var err error
+		kind, rate, err = s.block.Configure(configs[i], kind, rate)
+		if err != nil {
+			return nil, nil, fmt.Errorf("block %d (%s): %s", i, s.kind, err)
+		}
+		stages[i].rate = rate
+		if s.kind == protocol.BlockType_SinkBlock {
+			sinkRates = append(sinkRates, rate)
+			sinkKinds = append(sinkKinds, kind)
+		}
+	}
+
+	if len(stages) == 0 || stages[len(stages)-1].kind != protocol.BlockType_SinkBlock {
+		sinkRates = append(sinkRates, rate)
+		sinkKinds = append(sinkKinds, kind)
+	}
+
+	return sinkRates, sinkKinds, nil
+}
+
+// Configure changes the chain declaration or its input rate.
+// When the block types are unchanged the blocks are reconfigured in place, keeping their state,
+// otherwise the chain is rebuilt. On error the chain is left untouched.
+func (c *Chain) Configure(configs []*protocol.BlockConfig, sampleRate float64) error {
+	// Building a scratch chain validates the whole declaration before touching the running blocks
+	scratch := &Chain{}
+	if err := scratch.build(configs, sampleRate); err != nil {
+		return err
+	}
+
+	if !c.sameTypes(configs) {
+		*c = *scratch
+		return nil
+	}
+
+	sinkRates, sinkKinds, err := configureStages(c.stages, configs, sampleRate)
+	if err != nil {
+		// Should not happen as the scratch chain accepted it, but never keep a half configured chain
+		*c = *scratch
+		return nil
+	}
+
+	c.configs = configs
+	c.sampleRate = sampleRate
+	c.sinkRates = sinkRates
+	c.sinkKinds = sinkKinds
+
+	return nil
+}
+
+// SetSampleRate reconfigures the chain for a new input rate
+func (c *Chain) SetSampleRate(sampleRate float64) error {
+	return c.Configure(c.configs, sampleRate)
+}
+
+func (c *Chain) sameTypes(configs []*protocol.BlockConfig) bool {
+	if len(configs) != len(c.stages) {
+		return false
+	}
+	for i, bc := range configs {
+		if bc.Type != c.stages[i].kind {
+			return false
+		}
+	}
+	return true
+}
+
+// Configs returns the declaration of the chain
+func (c *Chain) Configs() []*protocol.BlockConfig {
+	return c.configs
+}
+
+// SampleRate returns the input rate of the chain
+func (c *Chain) SampleRate() float64 {
+	return c.sampleRate
+}
+
+// SinkRates returns the output rate of each sink
+func (c *Chain) SinkRates() []float64 {
+	return c.sinkRates
+}
+
+// SinkKinds returns the kind of samples produced by each sink
+func (c *Chain) SinkKinds() []protocol.SampleKind {
+	return c.sinkKinds
+}
+
+// Work runs the samples through the chain and returns the non empty sink outputs and the squelch events.
+// The samples held back by a squelch are counted in the Gated field of the next output of each sink downstream.
+func (c *Chain) Work(samples []complex64) ([]Output, []SquelchEvent) {
+	var outputs []Output
+	var events []SquelchEvent
+
+	buff := Buffer{
+		Kind:    protocol.SampleKind_IQSamples,
+		Complex: samples,
+	}
+	sink := uint32(0)
+	rate := c.sampleRate
+
+	// Samples held back before and after the ones in buff, at rate
+	var leading, trailing float64
+
+	for i, s := range c.stages {
+		if s.kind == protocol.BlockType_SinkBlock {
+			outputs = c.output(outputs, buff, sink, leading, trailing)
+			sink++
+			continue
+		}
+
+		if buff.Len() > 0 {
+			buff = s.block.Work(buff)
+		}
+
+		if sq, ok := s.block.(*squelchBlock); ok {
+			for _, e := range sq.takeEvents() {
+				e.Block = i
+				events = append(events, e)
+			}
+			l, t := sq.gated()
+			leading += float64(l)
+			trailing += float64(t)
+		}
+
+		if rate > 0 {
+			leading *= s.rate / rate
+			trailing *= s.rate / rate
+		}
+		rate = s.rate
+	}
+
+	if int(sink) < len(c.sinkRates) {
+		outputs = c.output(outputs, buff, sink, leading, trailing)
+	}
+
+	return outputs, events
+}
+
+// output appends b to outputs as an output of sink, if it isn't empty, and counts the samples held back around it
+func (c *Chain) output(outputs []Output, b Buffer, sink uint32, leading, trailing float64) []Output {
+	if b.Len() == 0 {
+		c.gated[sink] += leading + trailing
+		return outputs
+	}
+
+	gated := c.gated[sink] + leading
+	c.gated[sink] = gated - float64(uint64(gated)) + trailing
+
+	return append(outputs, Output{
+		Buffer:     b,
+		Sink:       sink,
+		SampleRate: c.sinkRates[sink],
+		Gated:      uint64(gated),
+	})
+}
+
+// Gated returns the samples of sink held back by a squelch and not counted by an output yet
+func (c *Chain) Gated(sink uint32) uint64 {
+	if int(sink) >= len(c.gated) {
+		return 0
+	}
+	return uint64(c.gated[sink])
+}
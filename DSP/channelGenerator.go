@@ -1,109 +1,219 @@
 package DSP
 
 import (
-	"github.com/quan-to/slog"
-	"github.com/racerxdl/go.fifo"
-	"runtime"
+	"context"
+	"fmt"
 	"sync"
-	"time"
+	"sync/atomic"
+
+	"github.com/luigifreitas/radioserver/protocol"
+	"github.com/quan-to/slog"
+	"github.com/racerxdl/segdsp/dsp"
 )
 
 var cgLog = slog.Scope("ChannelGenerator")
 
 const maxFifoSize = 4096
 
-type OnIQSamples func(samples []complex64)
+// OnOutput receives the outputs of the chain for the input block starting at hardwareIndex
+type OnOutput func(outputs []Output, hardwareIndex uint64)
+
+// OnSquelch receives the squelch events of the chain for the input block starting at hardwareIndex
+type OnSquelch func(events []SquelchEvent, hardwareIndex uint64)
+
+type sampleBlock struct {
+	samples       []complex64
+	hardwareIndex uint64
+}
 
 type ChannelGenerator struct {
+	// droppedSamples is accessed atomically, keep it first for 64 bit alignment
+	droppedSamples uint64
+
 	sync.Mutex
 
-	inputFifo     *fifo.Queue
+	input         *Queue
 	running       bool
+	ctx           context.Context
+	cancel        context.CancelFunc
+	done          chan bool
 	settingsMutex sync.Mutex
 
-	iqEnabled      bool
+	iqEnabled bool
+
+	corrector *IQCorrector
+
+	// shift moves the input by shiftFrequency Hertz, fine tuning what the frontend can't
+	shift          *dsp.Rotator
+	shiftFrequency float64
+
+	chain *Chain
+	sinks []sinkCounter
+	// consumed counts the pushed samples taken off the input, processed or accounted as dropped
+	consumed uint64
+
+	// pushLock guards the count of samples pushed and the device sample counter following them
+	pushLock    sync.Mutex
+	pushed      uint64
+	pushedIndex uint64
+
+	// gains are applied to the IQ and audio outputs, one per sink
+	gainSettings GainSettings
+	gains        []*GainControl
 
-	onIQSamples    OnIQSamples
-	updateChannel  chan bool
+	onOutput  OnOutput
+	onSquelch OnSquelch
+}
 
-	syncSampleInput *sync.Cond
+// sinkCounter numbers the samples of a chain output, accounting for the input samples dropped before the chain
+// and the ones held back by a squelch
+type sinkCounter struct {
+	index           uint64
+	frontendDropped uint64
+	squelched       uint64
+	fraction        float64
 }
 
-func CreateChannelGenerator() *ChannelGenerator {
+// CreateChannelGenerator returns a generator whose input follows policy when the chain falls behind.
+// The input is fed from the frontend callback, which must never wait, so Block is refused.
+func CreateChannelGenerator(policy OverflowPolicy) (*ChannelGenerator, error) {
+	if policy == Block {
+		return nil, fmt.Errorf("the %s policy would stall the frontend", policy)
+	}
+
+	chain, _ := MakeChain(nil, 0)
+
 	var cg = &ChannelGenerator{
 		Mutex:         sync.Mutex{},
-		inputFifo:     fifo.NewQueue(),
+		input:         NewQueue(maxFifoSize, policy),
 		settingsMutex: sync.Mutex{},
-		updateChannel: make(chan bool),
+		ctx:           context.Background(),
+		corrector:     MakeIQCorrector(),
+		shift:         dsp.MakeRotator(),
+		chain:         chain,
+		sinks:         make([]sinkCounter, 1),
+		gains:         []*GainControl{MakeGainControl()},
 	}
 
-	cg.syncSampleInput = sync.NewCond(cg)
-	return cg
+	return cg, nil
 }
 
 func (cg *ChannelGenerator) routine() {
-	for cg.running {
-		go func() {
-			<-time.After(1 * time.Second)
-			cg.syncSampleInput.Broadcast()
-		}()
-		cg.syncSampleInput.L.Lock()
-		cg.syncSampleInput.Wait()
-		cg.doWork()
-		cg.syncSampleInput.L.Unlock()
-
-		if !cg.running {
+	for {
+		item, err := cg.input.Pop(cg.ctx)
+		if err != nil {
 			break
 		}
-		runtime.Gosched()
+		cg.doWork(item.(sampleBlock))
 	}
 	cgLog.Debug("Cleaning fifo")
-	for i := 0; i < cg.inputFifo.Len(); i++ {
-		cg.inputFifo.Next()
-	}
+	cg.input.Clear()
+	cg.discardPending()
 	cgLog.Debug("Done")
+	close(cg.done)
 }
 
-func (cg *ChannelGenerator) doWork() {
+func (cg *ChannelGenerator) doWork(block sampleBlock) {
+	var outputs []Output
+	var events []SquelchEvent
+
 	cg.settingsMutex.Lock()
-	for cg.inputFifo.Len() > 0 {
-		var samples = cg.inputFifo.Next().([]complex64)
-		if cg.iqEnabled {
-			cg.processIQ(samples)
-		}
+	cg.consumed += uint64(len(block.samples))
+	if cg.iqEnabled {
+		outputs, events = cg.processIQ(block.samples)
 	}
+	onOutput, onSquelch := cg.onOutput, cg.onSquelch
 	cg.settingsMutex.Unlock()
+
+	// Delivered out of the lock, so the callbacks can change the settings
+	if onSquelch != nil && len(events) > 0 {
+		onSquelch(events, block.hardwareIndex)
+	}
+	if onOutput != nil && len(outputs) > 0 {
+		onOutput(outputs, block.hardwareIndex)
+	}
+}
+
+func (cg *ChannelGenerator) processIQ(samples []complex64) ([]Output, []SquelchEvent) {
+	dropped := atomic.SwapUint64(&cg.droppedSamples, 0)
+	cg.consumed += dropped
+	cg.countDropped(dropped)
+
+	samples = cg.corrector.Work(samples)
+	if cg.shiftFrequency != 0 {
+		samples = cg.shift.Work(samples)
+	}
+
+	outputs, events := cg.chain.Work(samples)
+	for i := range outputs {
+		o := &outputs[i]
+		switch o.Kind {
+		case protocol.SampleKind_IQSamples:
+			o.Complex = cg.gains[o.Sink].Work(o.Complex)
+			o.Gain = cg.gains[o.Sink].Gain()
+		case protocol.SampleKind_AudioSamples:
+			o.Real = cg.gains[o.Sink].WorkFloat(o.Real)
+			o.Gain = cg.gains[o.Sink].Gain()
+		}
+
+		c := &cg.sinks[outputs[i].Sink]
+		c.index += outputs[i].Gated
+		c.squelched += outputs[i].Gated
+		outputs[i].Index = c.index
+		outputs[i].FrontendDropped = c.frontendDropped
+		outputs[i].Squelched = c.squelched
+		c.index += uint64(outputs[i].Len())
+	}
+
+	return outputs, events
 }
 
-func (cg *ChannelGenerator) processIQ(samples []complex64) {
-	if cg.onIQSamples != nil {
-		cg.onIQSamples(samples)
+// countDropped advances the sink counters by the input samples dropped, converted to each sink rate
+func (cg *ChannelGenerator) countDropped(dropped uint64) {
+	if dropped == 0 || cg.chain.SampleRate() == 0 {
+		return
+	}
+
+	for i, rate := range cg.chain.SinkRates() {
+		c := &cg.sinks[i]
+		n := float64(dropped)*rate/cg.chain.SampleRate() + c.fraction
+		c.fraction = n - float64(uint64(n))
+		c.index += uint64(n)
+		c.frontendDropped += uint64(n)
 	}
 }
 
-func (cg *ChannelGenerator) notify() {
-	cg.syncSampleInput.Broadcast()
+// discardPending forgets the samples cleared from the input, which won't reach the sinks
+func (cg *ChannelGenerator) discardPending() {
+	cg.settingsMutex.Lock()
+	defer cg.settingsMutex.Unlock()
+	cg.pushLock.Lock()
+	defer cg.pushLock.Unlock()
+	cg.consumed = cg.pushed - atomic.LoadUint64(&cg.droppedSamples)
 }
 
-func (cg *ChannelGenerator) Start() {
+// Start starts processing the pushed samples until Stop is called or ctx is done
+func (cg *ChannelGenerator) Start(ctx context.Context) {
+	cg.Lock()
+	defer cg.Unlock()
 	if !cg.running {
 		cgLog.Info("Starting Channel Generator")
+		cg.ctx, cg.cancel = context.WithCancel(ctx)
+		cg.done = make(chan bool)
 		cg.running = true
 		go cg.routine()
-		//go func() {
-		//	for cg.running {
-		//		<-time.After(1 * time.Second)
-		//		cgLog.Debug("Fifo Usage: %d", cg.inputFifo.UnsafeLen())
-		//	}
-		//}()
 	}
 }
 
+// Stop stops processing and waits for the samples being processed to be delivered
 func (cg *ChannelGenerator) Stop() {
+	cg.Lock()
+	defer cg.Unlock()
 	if cg.running {
 		cgLog.Info("Stopping")
 		cg.running = false
-		cg.notify()
+		cg.cancel()
+		<-cg.done
 	}
 }
 
@@ -121,25 +231,174 @@ func (cg *ChannelGenerator) StopIQ() {
 	cg.settingsMutex.Unlock()
 }
 
-func (cg *ChannelGenerator) PushSamples(samples []complex64) {
-	if !cg.running {
+// PushSamples queues the samples from the frontend, hardwareIndex being the device sample counter of the first one
+func (cg *ChannelGenerator) PushSamples(samples []complex64, hardwareIndex uint64) {
+	cg.Lock()
+	running, ctx := cg.running, cg.ctx
+	cg.Unlock()
+
+	if !running {
 		return
 	}
 
-	var fifoLength = cg.inputFifo.Len()
+	block := sampleBlock{
+		samples:       samples,
+		hardwareIndex: hardwareIndex,
+	}
+
+	cg.pushLock.Lock()
+	cg.pushed += uint64(len(samples))
+	cg.pushedIndex = hardwareIndex + uint64(len(samples))
+	cg.pushLock.Unlock()
 
-	if maxFifoSize <= fifoLength {
+	if dropped := cg.input.Push(ctx, block); len(dropped) > 0 {
 		cgLog.Debug("Fifo Overflowing!")
-		return
+		for _, d := range dropped {
+			atomic.AddUint64(&cg.droppedSamples, uint64(len(d.(sampleBlock).samples)))
+		}
+	}
+}
+
+func (cg *ChannelGenerator) SetOnOutput(cb OnOutput) {
+	cg.settingsMutex.Lock()
+	cg.onOutput = cb
+	cg.settingsMutex.Unlock()
+}
+
+func (cg *ChannelGenerator) SetOnSquelch(cb OnSquelch) {
+	cg.settingsMutex.Lock()
+	cg.onSquelch = cb
+	cg.settingsMutex.Unlock()
+}
+
+// Configure replaces the processing chain, reconfiguring the running blocks in place when possible
+func (cg *ChannelGenerator) Configure(configs []*protocol.BlockConfig, sampleRate float64) error {
+	cg.settingsMutex.Lock()
+	defer cg.settingsMutex.Unlock()
+
+	if err := cg.chain.Configure(configs, sampleRate); err != nil {
+		return err
+	}
+	cg.updateShift()
+
+	sinks := make([]sinkCounter, len(cg.chain.SinkRates()))
+	copy(sinks, cg.sinks)
+	cg.sinks = sinks
+
+	gains := make([]*GainControl, len(sinks))
+	copy(gains, cg.gains)
+	for i := range gains {
+		if gains[i] == nil {
+			gains[i] = MakeGainControl()
+		}
+	}
+	cg.gains = gains
+	cg.updateGains()
+
+	return nil
+}
+
+// SetSampleRate reconfigures the chain for a new input rate
+func (cg *ChannelGenerator) SetSampleRate(sampleRate float64) error {
+	cg.settingsMutex.Lock()
+	defer cg.settingsMutex.Unlock()
+	err := cg.chain.SetSampleRate(sampleRate)
+	cg.updateShift()
+	cg.updateGains()
+	return err
+}
+
+// SetGain configures the digital gain of the IQ and audio outputs
+func (cg *ChannelGenerator) SetGain(settings GainSettings) {
+	cg.settingsMutex.Lock()
+	defer cg.settingsMutex.Unlock()
+	cg.gainSettings = settings
+	cg.updateGains()
+}
+
+func (cg *ChannelGenerator) updateGains() {
+	for i, rate := range cg.chain.SinkRates() {
+		cg.gains[i].Configure(cg.gainSettings, rate)
+	}
+}
+
+// SetFrequencyShift moves the input down by frequency Hertz before the chain
+func (cg *ChannelGenerator) SetFrequencyShift(frequency float64) {
+	cg.settingsMutex.Lock()
+	defer cg.settingsMutex.Unlock()
+	cg.shiftFrequency = frequency
+	cg.updateShift()
+}
+
+func (cg *ChannelGenerator) updateShift() {
+	if cg.chain.SampleRate() > 0 {
+		cg.shift.SetCenterFrequency(float32(cg.shiftFrequency), float32(cg.chain.SampleRate()))
 	}
+}
+
+// ChainConfigs returns the declaration of the processing chain
+func (cg *ChannelGenerator) ChainConfigs() []*protocol.BlockConfig {
+	cg.settingsMutex.Lock()
+	defer cg.settingsMutex.Unlock()
+	return cg.chain.Configs()
+}
+
+// SinkRates returns the output rate of each sink of the chain
+func (cg *ChannelGenerator) SinkRates() []float64 {
+	cg.settingsMutex.Lock()
+	defer cg.settingsMutex.Unlock()
+	return append([]float64(nil), cg.chain.SinkRates()...)
+}
+
+// SinkKinds returns the kind of samples produced by each sink of the chain
+func (cg *ChannelGenerator) SinkKinds() []protocol.SampleKind {
+	cg.settingsMutex.Lock()
+	defer cg.settingsMutex.Unlock()
+	return append([]protocol.SampleKind(nil), cg.chain.SinkKinds()...)
+}
 
-	cg.inputFifo.Add(samples)
+// SetCorrection switches the DC and IQ imbalance corrections applied before the chain
+func (cg *ChannelGenerator) SetCorrection(dc, iq bool) {
+	cg.settingsMutex.Lock()
+	defer cg.settingsMutex.Unlock()
+	cg.corrector.SetEnabled(dc, iq)
+}
+
+// Correction returns the enabled corrections and their current values
+func (cg *ChannelGenerator) Correction() (dc, iq bool, c IQCorrection) {
+	cg.settingsMutex.Lock()
+	defer cg.settingsMutex.Unlock()
+	dc, iq = cg.corrector.Enabled()
+	return dc, iq, cg.corrector.Correction()
+}
 
-	cg.notify()
+// ResetCorrection restarts the estimation of the corrections
+func (cg *ChannelGenerator) ResetCorrection() {
+	cg.settingsMutex.Lock()
+	defer cg.settingsMutex.Unlock()
+	cg.corrector.Reset()
 }
 
-func (cg *ChannelGenerator) SetOnIQ(cb OnIQSamples) {
-	cg.onIQSamples = cb
+// Boundary returns the device sample counter of the next sample pushed and the index it will have in the sink.
+// The samples still queued go through the current chain, so they are counted at its rates, squelched or not.
+func (cg *ChannelGenerator) Boundary(sink uint32) (hardwareIndex, sampleIndex uint64) {
+	cg.settingsMutex.Lock()
+	defer cg.settingsMutex.Unlock()
+
+	cg.pushLock.Lock()
+	pending, hardwareIndex := cg.pushed-cg.consumed, cg.pushedIndex
+	cg.pushLock.Unlock()
+
+	if int(sink) >= len(cg.sinks) {
+		return hardwareIndex, 0
+	}
+
+	c := cg.sinks[sink]
+	sampleIndex = c.index + cg.chain.Gated(sink)
+	if cg.chain.SampleRate() > 0 {
+		sampleIndex += uint64(float64(pending)*cg.chain.SinkRates()[sink]/cg.chain.SampleRate() + c.fraction)
+	}
+	return hardwareIndex, sampleIndex
 }
 
 func (cg *ChannelGenerator) IQRunning() bool {
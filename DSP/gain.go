@@ -0,0 +1,146 @@
+package DSP
+
+import (
+	"math"
+)
+
+const (
+	defaultAGCAttack    = 0.01
+	defaultAGCDecay     = 0.5
+	defaultAGCReference = 0.25
+	defaultAGCMaxGain   = 60
+)
+
+// GainSettings configures the digital gain applied to the chain outputs
+type GainSettings struct {
+	// Gain is a fixed gain in dB
+	Gain float64
+
+	AGC bool
+
+	// Attack and Decay are the time constants of the AGC envelope in seconds, when the level rises and falls
+	Attack float64
+	Decay  float64
+
+	// Reference is the amplitude the AGC keeps the output at
+	Reference float64
+
+	// MaxGain bounds the AGC gain, in dB
+	MaxGain float64
+}
+
+// GainControl applies a fixed gain followed by an optional attack / decay AGC to IQ or audio samples
+type GainControl struct {
+	settings GainSettings
+	gain     float32
+
+	attackRate float64
+	decayRate  float64
+	reference  float64
+	maxGain    float64
+
+	// envelope is the amplitude followed by the AGC
+	envelope float64
+}
+
+// MakeGainControl creates a gain control with unity gain
+func MakeGainControl() *GainControl {
+	g := &GainControl{}
+	g.Configure(GainSettings{}, 0)
+	return g
+}
+
+// Configure applies the settings for samples at sampleRate, keeping the AGC envelope
+func (g *GainControl) Configure(s GainSettings, sampleRate float64) {
+	if s.Attack <= 0 {
+		s.Attack = defaultAGCAttack
+	}
+	if s.Decay <= 0 {
+		s.Decay = defaultAGCDecay
+	}
+	if s.Reference <= 0 {
+		s.Reference = defaultAGCReference
+	}
+	if s.MaxGain <= 0 {
+		s.MaxGain = defaultAGCMaxGain
+	}
+
+	g.settings = s
+	g.gain = float32(math.Pow(10, s.Gain/20))
+	g.reference = s.Reference
+	g.maxGain = math.Pow(10, s.MaxGain/20)
+	g.attackRate = smoothingRate(s.Attack, sampleRate)
+	g.decayRate = smoothingRate(s.Decay, sampleRate)
+
+	if g.envelope <= 0 {
+		g.envelope = g.reference
+	}
+}
+
+// smoothingRate returns the per sample weight of a single pole filter with time constant tau at sampleRate
+func smoothingRate(tau, sampleRate float64) float64 {
+	if sampleRate <= 0 {
+		return 1
+	}
+	return 1 - math.Exp(-1/(tau*sampleRate))
+}
+
+// Gain returns the gain applied to the last sample, in dB
+func (g *GainControl) Gain() float32 {
+	gain := 20 * math.Log10(float64(g.gain))
+	if g.settings.AGC {
+		gain += 20 * math.Log10(g.agcGain())
+	}
+	return float32(gain)
+}
+
+func (g *GainControl) agcGain() float64 {
+	return math.Min(g.reference/math.Max(g.envelope, 1e-20), g.maxGain)
+}
+
+// track updates the envelope with the amplitude of a sample and returns the AGC gain for it
+func (g *GainControl) track(amplitude float64) float32 {
+	rate := g.decayRate
+	if amplitude > g.envelope {
+		rate = g.attackRate
+	}
+	g.envelope += rate * (amplitude - g.envelope)
+	return float32(g.agcGain())
+}
+
+// Work applies the gain to IQ samples
+func (g *GainControl) Work(data []complex64) []complex64 {
+	if g.gain == 1 && !g.settings.AGC {
+		return data
+	}
+
+	out := make([]complex64, len(data))
+	for i, v := range data {
+		v *= complex(g.gain, 0)
+		if g.settings.AGC {
+			a := math.Hypot(float64(real(v)), float64(imag(v)))
+			v *= complex(g.track(a), 0)
+		}
+		out[i] = v
+	}
+
+	return out
+}
+
+// WorkFloat applies the gain to real samples
+func (g *GainControl) WorkFloat(data []float32) []float32 {
+	if g.gain == 1 && !g.settings.AGC {
+		return data
+	}
+
+	out := make([]float32, len(data))
+	for i, v := range data {
+		v *= g.gain
+		if g.settings.AGC {
+			v *= g.track(math.Abs(float64(v)))
+		}
+		out[i] = v
+	}
+
+	return out
+}
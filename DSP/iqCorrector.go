@@ -0,0 +1,163 @@
+package DSP
+
+import (
+	"math"
+)
+
+const (
+	// dcAlpha is the per sample weight of the DC offset estimation
+	dcAlpha = 1e-5
+
+	// iqAlpha is the per sample weight of the IQ imbalance estimation
+	iqAlpha = 1e-6
+
+	// maxPhaseImbalance bounds the phase correction, larger estimates come from a non random input
+	maxPhaseImbalance = math.Pi / 8
+)
+
+// IQCorrector removes the DC offset and the gain / phase imbalance between I and Q of a channel.
+// The imbalance is estimated blindly, assuming I and Q of the received signal are uncorrelated
+// and of equal power over time: Q is scaled back to the power of I and the part of I leaking on it removed.
+type IQCorrector struct {
+	dcEnabled bool
+	iqEnabled bool
+
+	dcI, dcQ float64
+
+	// Running powers of I and Q and their correlation, after the DC removal
+	powerI, powerQ, cross float64
+}
+
+// IQCorrection are the correction values currently applied by an IQCorrector
+type IQCorrection struct {
+	DCOffset complex64
+
+	// GainImbalance is the amplitude of Q relative to I
+	GainImbalance float32
+
+	// PhaseImbalance is the deviation from quadrature between I and Q, in radians
+	PhaseImbalance float32
+}
+
+// MakeIQCorrector creates a disabled corrector
+func MakeIQCorrector() *IQCorrector {
+	c := &IQCorrector{}
+	c.Reset()
+	return c
+}
+
+// SetEnabled switches the DC and the IQ imbalance corrections
+func (c *IQCorrector) SetEnabled(dc, iq bool) {
+	c.dcEnabled = dc
+	c.iqEnabled = iq
+}
+
+// Enabled returns whether the DC and the IQ imbalance corrections are enabled
+func (c *IQCorrector) Enabled() (dc, iq bool) {
+	return c.dcEnabled, c.iqEnabled
+}
+
+// Reset discards the estimations, as after a hardware calibration
+func (c *IQCorrector) Reset() {
+	c.dcI, c.dcQ = 0, 0
+	c.powerI, c.powerQ, c.cross = 0, 0, 0
+}
+
+// Correction returns the correction values, zero when the matching correction is disabled
+func (c *IQCorrector) Correction() IQCorrection {
+	r := IQCorrection{
+		GainImbalance: 1,
+	}
+
+	if c.dcEnabled {
+		r.DCOffset = complex(float32(c.dcI), float32(c.dcQ))
+	}
+
+	if c.iqEnabled {
+		gain, phase := c.imbalance()
+		r.GainImbalance = float32(gain)
+		r.PhaseImbalance = float32(phase)
+	}
+
+	return r
+}
+
+// imbalance returns the estimated gain and phase imbalance
+func (c *IQCorrector) imbalance() (float64, float64) {
+	if c.powerI <= 0 || c.powerQ <= 0 {
+		return 1, 0
+	}
+
+	gain := math.Sqrt(c.powerQ / c.powerI)
+	phase := math.Asin(math.Max(-1, math.Min(1, c.cross/math.Sqrt(c.powerI*c.powerQ))))
+	phase = math.Max(-maxPhaseImbalance, math.Min(maxPhaseImbalance, phase))
+
+	return gain, phase
+}
+
+// blockWeight returns the weight of a block of n samples on an estimation with a per sample weight alpha
+func blockWeight(alpha float64, n int) float64 {
+	return 1 - math.Pow(1-alpha, float64(n))
+}
+
+// Work corrects the samples, the estimations are updated with the statistics of the whole buffer
+func (c *IQCorrector) Work(data []complex64) []complex64 {
+	if (!c.dcEnabled && !c.iqEnabled) || len(data) == 0 {
+		return data
+	}
+
+	n := float64(len(data))
+
+	if c.dcEnabled {
+		var sumI, sumQ float64
+		for _, v := range data {
+			sumI += float64(real(v))
+			sumQ += float64(imag(v))
+		}
+		w := blockWeight(dcAlpha, len(data))
+		c.dcI += w * (sumI/n - c.dcI)
+		c.dcQ += w * (sumQ/n - c.dcQ)
+	}
+
+	var dc complex64
+	if c.dcEnabled {
+		dc = complex(float32(c.dcI), float32(c.dcQ))
+	}
+
+	out := make([]complex64, len(data))
+	if !c.iqEnabled {
+		for i, v := range data {
+			out[i] = v - dc
+		}
+		return out
+	}
+
+	var pI, pQ, cross float64
+	for i, v := range data {
+		v -= dc
+		out[i] = v
+		pI += float64(real(v) * real(v))
+		pQ += float64(imag(v) * imag(v))
+		cross += float64(real(v) * imag(v))
+	}
+
+	w := blockWeight(iqAlpha, len(data))
+	if c.powerI == 0 && c.powerQ == 0 {
+		// Start from the first block instead of converging from zero
+		w = 1
+	}
+	c.powerI += w * (pI/n - c.powerI)
+	c.powerQ += w * (pQ/n - c.powerQ)
+	c.cross += w * (cross/n - c.cross)
+
+	gain, phase := c.imbalance()
+	// Q = gain * (Q0 cos(phase) + I sin(phase)), so Q0 = (Q / gain - I sin(phase)) / cos(phase)
+	scale := float32(1 / (gain * math.Cos(phase)))
+	leak := float32(math.Tan(phase))
+
+	for i, v := range out {
+		out[i] = complex(real(v), imag(v)*scale-real(v)*leak)
+	}
+
+	return out
+}
@@ -0,0 +1,122 @@
+package DSP
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// imbalanced returns n samples of white noise with DC offset dc and Q distorted by gain and phase
+func imbalanced(rnd *rand.Rand, n int, dc complex64, gain, phase float64) []complex64 {
+	out := make([]complex64, n)
+	for k := range out {
+		i, q := rnd.NormFloat64()*0.1, rnd.NormFloat64()*0.1
+		q = gain * (q*math.Cos(phase) + i*math.Sin(phase))
+		out[k] = complex(float32(i), float32(q)) + dc
+	}
+	return out
+}
+
+func TestIQCorrectorConvergence(t *testing.T) {
+	tests := []struct {
+		dc    complex64
+		gain  float64
+		phase float64
+	}{
+		{0, 1, 0},
+		{0.05 - 0.02i, 1.2, 0.1},
+		{-0.01 + 0.03i, 0.8, -0.05},
+		{0.02, 1.05, 0.2},
+	}
+
+	for _, tt := range tests {
+		rnd := rand.New(rand.NewSource(1))
+		c := MakeIQCorrector()
+		c.SetEnabled(true, true)
+
+		// Long enough for the DC estimation to settle
+		var out []complex64
+		for b := 0; b < 200; b++ {
+			out = c.Work(imbalanced(rnd, 16384, tt.dc, tt.gain, tt.phase))
+		}
+
+		r := c.Correction()
+		if math.Abs(float64(real(r.DCOffset)-real(tt.dc))) > 0.005 || math.Abs(float64(imag(r.DCOffset)-imag(tt.dc))) > 0.005 {
+			t.Errorf("%v: DC offset estimated as %v", tt, r.DCOffset)
+		}
+		if math.Abs(float64(r.GainImbalance)-tt.gain) > 0.02 {
+			t.Errorf("%v: gain imbalance estimated as %v", tt, r.GainImbalance)
+		}
+		if math.Abs(float64(r.PhaseImbalance)-tt.phase) > 0.02 {
+			t.Errorf("%v: phase imbalance estimated as %v", tt, r.PhaseImbalance)
+		}
+
+		var pI, pQ, cross float64
+		for _, v := range out {
+			pI += float64(real(v) * real(v))
+			pQ += float64(imag(v) * imag(v))
+			cross += float64(real(v) * imag(v))
+		}
+		if math.Abs(pQ/pI-1) > 0.05 {
+			t.Errorf("%v: corrected Q / I power ratio is %v", tt, pQ/pI)
+		}
+		if math.Abs(cross/math.Sqrt(pI*pQ)) > 0.03 {
+			t.Errorf("%v: corrected I / Q correlation is %v", tt, cross/math.Sqrt(pI*pQ))
+		}
+	}
+}
+
+func TestIQCorrectorDisabled(t *testing.T) {
+	c := MakeIQCorrector()
+	data := imbalanced(rand.New(rand.NewSource(1)), 1024, 0.1, 1.5, 0.2)
+
+	out := c.Work(data)
+	for i := range data {
+		if out[i] != data[i] {
+			t.Fatalf("sample %d changed by a disabled corrector", i)
+		}
+	}
+
+	if r := c.Correction(); r.DCOffset != 0 || r.GainImbalance != 1 || r.PhaseImbalance != 0 {
+		t.Errorf("disabled corrector reports %v", r)
+	}
+}
+
+func TestIQCorrectorDCOnly(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	c := MakeIQCorrector()
+	c.SetEnabled(true, false)
+
+	for b := 0; b < 200; b++ {
+		c.Work(imbalanced(rnd, 16384, 0.05-0.02i, 1.2, 0.1))
+	}
+
+	r := c.Correction()
+	if math.Abs(float64(real(r.DCOffset))-0.05) > 0.005 || math.Abs(float64(imag(r.DCOffset))+0.02) > 0.005 {
+		t.Errorf("DC offset estimated as %v", r.DCOffset)
+	}
+	if r.GainImbalance != 1 || r.PhaseImbalance != 0 {
+		t.Errorf("imbalance %v / %v corrected while only the DC correction is enabled", r.GainImbalance, r.PhaseImbalance)
+	}
+}
+
+func TestIQCorrectorReset(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	c := MakeIQCorrector()
+	c.SetEnabled(true, true)
+
+	for b := 0; b < 50; b++ {
+		c.Work(imbalanced(rnd, 16384, 0.05, 1.2, 0.1))
+	}
+	if r := c.Correction(); r.DCOffset == 0 || r.GainImbalance == 1 {
+		t.Fatalf("nothing estimated before the reset: %v", r)
+	}
+
+	c.Reset()
+	if r := c.Correction(); r.DCOffset != 0 || r.GainImbalance != 1 || r.PhaseImbalance != 0 {
+		t.Errorf("reset corrector reports %v", r)
+	}
+	if dc, iq := c.Enabled(); !dc || !iq {
+		t.Error("reset disabled the corrections")
+	}
+}
@@ -0,0 +1,104 @@
+package DSP
+
+import "context"
+
+// OverflowPolicy defines what a Queue does when an item is pushed while it is full
+type OverflowPolicy int
+
+const (
+	// DropNewest discards the item being pushed
+	DropNewest OverflowPolicy = iota
+	// DropOldest discards the oldest queued item to make room
+	DropOldest
+	// Block waits until there is room or the push context is done.
+	// It stalls the producer, so it is only meant for queues fed from the processing side, never from a frontend.
+	Block
+)
+
+var OverflowPolicyNames = map[OverflowPolicy]string{
+	DropNewest: "drop-newest",
+	DropOldest: "drop-oldest",
+	Block:      "block",
+}
+
+func (p OverflowPolicy) String() string {
+	return OverflowPolicyNames[p]
+}
+
+// Queue is a bounded queue with a blocking handoff to the consumer
+type Queue struct {
+	items  chan interface{}
+	policy OverflowPolicy
+}
+
+func NewQueue(size int, policy OverflowPolicy) *Queue {
+	return &Queue{
+		items:  make(chan interface{}, size),
+		policy: policy,
+	}
+}
+
+// Push adds item to the queue following the overflow policy.
+// It returns the items that had to be discarded, which is item itself if it could not be queued.
+func (q *Queue) Push(ctx context.Context, item interface{}) (dropped []interface{}) {
+	switch q.policy {
+	case Block:
+		select {
+		case q.items <- item:
+			return nil
+		case <-ctx.Done():
+			return []interface{}{item}
+		}
+	case DropOldest:
+		for {
+			select {
+			case q.items <- item:
+				return dropped
+			default:
+			}
+
+			select {
+			case oldest := <-q.items:
+				dropped = append(dropped, oldest)
+			default:
+			}
+		}
+	default:
+		select {
+		case q.items <- item:
+			return nil
+		default:
+			return []interface{}{item}
+		}
+	}
+}
+
+// Pop waits for the next item until ctx is done
+func (q *Queue) Pop(ctx context.Context) (interface{}, error) {
+	select {
+	case item := <-q.items:
+		return item, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Items returns the channel the items are delivered on, to be used in a select
+func (q *Queue) Items() <-chan interface{} {
+	return q.items
+}
+
+func (q *Queue) Len() int {
+	return len(q.items)
+}
+
+// Clear discards all queued items
+func (q *Queue) Clear() {
+	for {
+		select {
+		case <-q.items:
+		default:
+			return
+		}
+	}
+}
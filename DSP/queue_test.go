@@ -0,0 +1,98 @@
+package DSP
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestQueueOverflow(t *testing.T) {
+	tests := []struct {
+		policy  OverflowPolicy
+		dropped [][]interface{}
+		queued  []interface{}
+	}{
+		{DropNewest, [][]interface{}{nil, nil, {3}, {4}}, []interface{}{1, 2}},
+		{DropOldest, [][]interface{}{nil, nil, {1}, {2}}, []interface{}{3, 4}},
+	}
+
+	for _, tt := range tests {
+		q := NewQueue(2, tt.policy)
+		for i, want := range tt.dropped {
+			if got := q.Push(context.Background(), i+1); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: push of %d dropped %v, want %v", tt.policy, i+1, got, want)
+			}
+		}
+
+		if q.Len() != len(tt.queued) {
+			t.Errorf("%s: %d items queued, want %d", tt.policy, q.Len(), len(tt.queued))
+		}
+		for _, want := range tt.queued {
+			if got, _ := q.Pop(context.Background()); got != want {
+				t.Errorf("%s: popped %v, want %v", tt.policy, got, want)
+			}
+		}
+	}
+}
+
+func TestQueueBlock(t *testing.T) {
+	q := NewQueue(1, Block)
+	q.Push(context.Background(), 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+	defer cancel()
+	if got := q.Push(ctx, 2); !reflect.DeepEqual(got, []interface{}{2}) {
+		t.Errorf("push on a full queue returned %v once its context is done, want 2", got)
+	}
+
+	pushed := make(chan []interface{})
+	go func() {
+		pushed <- q.Push(context.Background(), 3)
+	}()
+
+	if got, _ := q.Pop(context.Background()); got != 1 {
+		t.Errorf("popped %v, want 1", got)
+	}
+	if got := <-pushed; got != nil {
+		t.Errorf("blocked push dropped %v", got)
+	}
+	if got, _ := q.Pop(context.Background()); got != 3 {
+		t.Errorf("popped %v, want 3", got)
+	}
+}
+
+func TestQueueDropOldestUnderLoad(t *testing.T) {
+	q := NewQueue(4, DropOldest)
+
+	const producers, pushes = 4, 1000
+	counts := make(chan int, producers)
+	for p := 0; p < producers; p++ {
+		go func() {
+			dropped := 0
+			for i := 0; i < pushes; i++ {
+				dropped += len(q.Push(context.Background(), i))
+			}
+			counts <- dropped
+		}()
+	}
+
+	dropped := 0
+	for p := 0; p < producers; p++ {
+		dropped += <-counts
+	}
+
+	// Every item pushed is either still queued or reported dropped by exactly one push
+	if dropped+q.Len() != producers*pushes {
+		t.Errorf("%d items dropped and %d queued, want %d in total", dropped, q.Len(), producers*pushes)
+	}
+}
+
+func TestChannelGeneratorRefusesBlock(t *testing.T) {
+	if _, err := CreateChannelGenerator(Block); err == nil {
+		t.Error("the input of the channel generator should refuse to block the frontend")
+	}
+	if _, err := CreateChannelGenerator(DropOldest); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,221 @@
+package DSP
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/racerxdl/segdsp/dsp"
+)
+
+const (
+	// maxInterpolation bounds the number of polyphase branches of a resampler
+	maxInterpolation = 1024
+
+	// ratioTolerance is the relative error of the output rate accepted when the exact ratio needs too many branches,
+	// in the order of the frequency error of the device clocks
+	ratioTolerance = 1e-5
+
+	// resamplerTransition is the transition width of the resampler filter, relative to the narrower band
+	resamplerTransition = 0.1
+
+	// minFinalDecimation is the decimation left to the polyphase filter after the integer decimation stages,
+	// keeping the aliases of those stages away from the output band
+	minFinalDecimation = 4
+
+	// maxStageDecimation bounds each integer decimation stage, as their filters have a fixed length
+	maxStageDecimation = 8
+)
+
+// RationalRatio returns the smallest interpolation and decimation that convert inputRate to outputRate.
+// The rates are rounded to the closest Hertz. When the exact ratio needs more than maxInterpolation branches,
+// the smallest ratio within ratioTolerance of outputRate is returned instead.
+func RationalRatio(inputRate, outputRate float64) (int, int, error) {
+	in := int64(math.Round(inputRate))
+	out := int64(math.Round(outputRate))
+	if in <= 0 || out <= 0 {
+		return 0, 0, fmt.Errorf("invalid rates %v and %v", inputRate, outputRate)
+	}
+
+	d := in
+	for b := out; b != 0; {
+		d, b = b, d%b
+	}
+
+	interpolation, decimation := out/d, in/d
+	if interpolation <= maxInterpolation {
+		return int(interpolation), int(decimation), nil
+	}
+
+	for interpolation := int64(1); interpolation <= maxInterpolation; interpolation++ {
+		decimation := int64(math.Round(float64(interpolation*in) / float64(out)))
+		if decimation == 0 {
+			continue
+		}
+		rate := float64(in) * float64(interpolation) / float64(decimation)
+		if math.Abs(rate-float64(out)) <= float64(out)*ratioTolerance {
+			return int(interpolation), int(decimation), nil
+		}
+	}
+
+	return 0, 0, fmt.Errorf("can't resample %d to %d S/s within %v ppm with up to %d polyphase branches, the exact ratio needs %d",
+		in, out, ratioTolerance*1e6, maxInterpolation, interpolation)
+}
+
+// PolyphaseResampler changes the rate of a signal by interpolation / decimation,
+// only computing the filter branch needed by each output sample
+type PolyphaseResampler struct {
+	interpolation int
+	decimation    int
+
+	// phases holds the filter branches with their taps reversed
+	phases [][]float32
+
+	history  []complex64
+	fHistory []float32
+
+	// offset is the position of the next output in the interpolated input, relative to the next input buffer
+	offset int
+}
+
+// MakePolyphaseResampler creates a resampler with a low pass at the narrower of the input and output bands
+func MakePolyphaseResampler(interpolation, decimation int) *PolyphaseResampler {
+	return makePolyphaseResampler(interpolation, decimation, 0.5-resamplerTransition/2, resamplerTransition)
+}
+
+// makePolyphaseResampler creates a resampler with a low pass of cutoff and transition relative to the narrower band
+func makePolyphaseResampler(interpolation, decimation int, cutoff, transition float64) *PolyphaseResampler {
+	// Rates normalized to the input rate
+	band := math.Min(1, float64(interpolation)/float64(decimation))
+	taps := dsp.MakeLowPass(float64(interpolation), float64(interpolation), band*cutoff, band*transition)
+
+	k := (len(taps) + interpolation - 1) / interpolation
+	phases := make([][]float32, interpolation)
+	for p := range phases {
+		phases[p] = make([]float32, k)
+		for j := 0; j < k; j++ {
+			if t := p + (k-1-j)*interpolation; t < len(taps) {
+				phases[p][j] = taps[t]
+			}
+		}
+	}
+
+	return &PolyphaseResampler{
+		interpolation: interpolation,
+		decimation:    decimation,
+		phases:        phases,
+		history:       make([]complex64, k-1),
+		fHistory:      make([]float32, k-1),
+	}
+}
+
+// outputs calls fn with the input position and filter branch of each output sample of an input of length n
+func (r *PolyphaseResampler) outputs(n int, fn func(i int, phase []float32)) {
+	u := r.offset
+	for ; u/r.interpolation < n; u += r.decimation {
+		fn(u/r.interpolation, r.phases[u%r.interpolation])
+	}
+	r.offset = u - n*r.interpolation
+}
+
+// Work resamples IQ samples
+func (r *PolyphaseResampler) Work(data []complex64) []complex64 {
+	k := len(r.phases[0])
+	samples := append(r.history, data...)
+	out := make([]complex64, 0, len(data)*r.interpolation/r.decimation+1)
+
+	r.outputs(len(data), func(i int, phase []float32) {
+		out = append(out, dsp.DotProductResult(samples[i:i+k], phase))
+	})
+
+	r.history = append([]complex64(nil), samples[len(samples)-(k-1):]...)
+	return out
+}
+
+// WorkFloat resamples real samples
+func (r *PolyphaseResampler) WorkFloat(data []float32) []float32 {
+	k := len(r.phases[0])
+	samples := append(r.fHistory, data...)
+	out := make([]float32, 0, len(data)*r.interpolation/r.decimation+1)
+
+	r.outputs(len(data), func(i int, phase []float32) {
+		out = append(out, dsp.DotProductFloatResult(samples[i:i+k], phase))
+	})
+
+	r.fHistory = append([]float32(nil), samples[len(samples)-(k-1):]...)
+	return out
+}
+
+// Resampler converts between rational rates. Large decimations are split in integer
+// decimation stages before the rational one, keeping the filters short.
+type Resampler struct {
+	stages []*PolyphaseResampler
+}
+
+// MakeResampler creates a resampler by interpolation / decimation
+func MakeResampler(interpolation, decimation int) *Resampler {
+	r := &Resampler{}
+
+	var factors []int
+	for p := 2; p*minFinalDecimation*interpolation <= decimation; p++ {
+		for decimation%p == 0 && decimation/p >= minFinalDecimation*interpolation {
+			factors = append(factors, p)
+			decimation /= p
+		}
+	}
+
+	// Each decimation stage only has to keep its aliases off the final band,
+	// which is at most half of the remaining decimation
+	remaining := float64(decimation) / float64(interpolation)
+	stages := decimationStages(factors)
+	for i := len(stages) - 1; i >= 0; i-- {
+		r.stages = append([]*PolyphaseResampler{makePolyphaseResampler(1, stages[i], 0.5, 1-1/remaining)}, r.stages...)
+		remaining *= float64(stages[i])
+	}
+
+	if interpolation != decimation {
+		r.stages = append(r.stages, MakePolyphaseResampler(interpolation, decimation))
+	}
+
+	return r
+}
+
+// decimationStages groups the factors in stages of up to maxStageDecimation, the largest first
+// as the first stage runs at the highest rate
+func decimationStages(factors []int) []int {
+	sort.Sort(sort.Reverse(sort.IntSlice(factors)))
+
+	var stages []int
+	for _, f := range factors {
+		placed := false
+		for i := range stages {
+			if stages[i]*f <= maxStageDecimation {
+				stages[i] *= f
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			stages = append(stages, f)
+		}
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(stages)))
+	return stages
+}
+
+// Work resamples IQ samples
+func (r *Resampler) Work(data []complex64) []complex64 {
+	for _, s := range r.stages {
+		data = s.Work(data)
+	}
+	return data
+}
+
+// WorkFloat resamples real samples
+func (r *Resampler) WorkFloat(data []float32) []float32 {
+	for _, s := range r.stages {
+		data = s.WorkFloat(data)
+	}
+	return data
+}
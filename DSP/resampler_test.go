@@ -0,0 +1,131 @@
+package DSP
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestRationalRatio(t *testing.T) {
+	tests := []struct {
+		in, out                   float64
+		interpolation, decimation int
+		fails                     bool
+	}{
+		{2e6, 48e3, 3, 125, false},
+		{10e6, 2e6, 1, 5, false},
+		{2.4e6, 2.4e6, 1, 1, false},
+		{1e6, 1.5e6, 3, 2, false},
+		{30.72e6, 44100, 147, 102400, false},
+		{3e6, 2.048e6, 256, 375, false},
+		// The exact ratios need too many branches, the closest rates are within the tolerance
+		{2999999, 2.048e6, 256, 375, false},
+		{1e6, 1000003, 1, 1, false},
+		{30.72e6, 44101, 12, 8359, false},
+		{1e6, 1000500, 0, 0, true},
+		{0, 48e3, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		interpolation, decimation, err := RationalRatio(tt.in, tt.out)
+		if tt.fails {
+			if err == nil {
+				t.Errorf("%v to %v: expected an error", tt.in, tt.out)
+			}
+			continue
+		}
+		if err != nil || interpolation != tt.interpolation || decimation != tt.decimation {
+			t.Errorf("%v to %v: got %d / %d (%v), want %d / %d", tt.in, tt.out, interpolation, decimation, err,
+				tt.interpolation, tt.decimation)
+		}
+	}
+}
+
+// tone returns n samples of a complex tone of frequency f relative to the sample rate, starting at sample start
+func tone(f float64, start, n int) []complex64 {
+	out := make([]complex64, n)
+	for i := range out {
+		out[i] = complex64(cmplx.Rect(1, 2*math.Pi*f*float64(start+i)))
+	}
+	return out
+}
+
+func TestResamplerOutputRate(t *testing.T) {
+	tests := []struct {
+		interpolation, decimation int
+	}{
+		{1, 5},
+		{3, 125},
+		{3, 2},
+		{1, 64},
+		{147, 160},
+	}
+
+	const buffers, size = 50, 1000
+
+	for _, tt := range tests {
+		r := MakeResampler(tt.interpolation, tt.decimation)
+
+		produced := 0
+		for b := 0; b < buffers; b++ {
+			produced += len(r.Work(tone(0.001, b*size, size)))
+		}
+
+		want := buffers * size * tt.interpolation / tt.decimation
+		if produced < want-1 || produced > want+1 {
+			t.Errorf("%d / %d: produced %d samples, want %d", tt.interpolation, tt.decimation, produced, want)
+		}
+	}
+}
+
+func TestResamplerPhaseContinuity(t *testing.T) {
+	tests := []struct {
+		interpolation, decimation int
+		chunks                    []int
+	}{
+		{1, 5, []int{1000, 1, 333, 2666}},
+		{3, 125, []int{4000, 7, 993, 1000}},
+		{3, 2, []int{17, 1000, 983, 2000}},
+		{147, 160, []int{1500, 1500, 1}},
+	}
+
+	for _, tt := range tests {
+		total := 0
+		for _, n := range tt.chunks {
+			total += n
+		}
+		input := tone(0.01, 0, total)
+
+		whole := MakeResampler(tt.interpolation, tt.decimation).Work(input)
+
+		r := MakeResampler(tt.interpolation, tt.decimation)
+		var chunked []complex64
+		offset := 0
+		for _, n := range tt.chunks {
+			chunked = append(chunked, r.Work(input[offset:offset+n])...)
+			offset += n
+		}
+
+		if len(chunked) != len(whole) {
+			t.Errorf("%d / %d: %d samples in chunks, %d at once", tt.interpolation, tt.decimation, len(chunked), len(whole))
+			continue
+		}
+		for i := range whole {
+			if cmplx.Abs(complex128(chunked[i]-whole[i])) > 1e-4 {
+				t.Errorf("%d / %d: sample %d is %v in chunks, %v at once", tt.interpolation, tt.decimation, i, chunked[i], whole[i])
+				break
+			}
+		}
+
+		// Past the filter delay the output is the same tone at the new rate, so consecutive samples
+		// turn by the same angle
+		step := 2 * math.Pi * 0.01 * float64(tt.decimation) / float64(tt.interpolation)
+		for i := len(whole) / 2; i < len(whole)-1; i++ {
+			turn := cmplx.Phase(complex128(whole[i+1]) / complex128(whole[i]))
+			if math.Abs(turn-step) > 1e-2 {
+				t.Errorf("%d / %d: phase step %v at %d, want %v", tt.interpolation, tt.decimation, turn, i, step)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package DSP
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/racerxdl/segdsp/dsp/fft"
+)
+
+// Peak is a run of spectrum bins above a threshold
+type Peak struct {
+	// Bin is the strongest bin of the run, First and Last its bounds
+	Bin   int
+	First int
+	Last  int
+
+	Power float32
+}
+
+// PowerSpectrum returns the power spectrum of samples averaged over frames of size, in dB.
+// The bins are shifted so the first one is the lowest frequency, a full scale tone reads 0 dB.
+func PowerSpectrum(samples []complex64, size int) ([]float32, error) {
+	if size <= 0 || len(samples) < size {
+		return nil, fmt.Errorf("not enough samples for a %d bins spectrum", size)
+	}
+
+	window := spectrumWindow(size)
+	power := make([]float64, size)
+	frame := make([]complex64, size)
+	frames := 0
+
+	for o := 0; o+size <= len(samples); o += size {
+		for i := range frame {
+			frame[i] = samples[o+i] * complex(window[i], 0)
+		}
+		for i, v := range fft.FFT(frame) {
+			power[i] += float64(real(v)*real(v) + imag(v)*imag(v))
+		}
+		frames++
+	}
+
+	half := size / 2
+	spectrum := make([]float32, size)
+	for i := range spectrum {
+		spectrum[i] = float32(10 * math.Log10(power[(i+size-half)%size]/float64(frames)+1e-20))
+	}
+
+	return spectrum, nil
+}
+
+// FindPeaks returns the runs of bins of spectrum above threshold dB
+func FindPeaks(spectrum []float32, threshold float32) []Peak {
+	var peaks []Peak
+
+	for i := 0; i < len(spectrum); i++ {
+		if spectrum[i] < threshold {
+			continue
+		}
+
+		p := Peak{
+			Bin:   i,
+			First: i,
+			Power: spectrum[i],
+		}
+		for ; i < len(spectrum) && spectrum[i] >= threshold; i++ {
+			if spectrum[i] > p.Power {
+				p.Bin = i
+				p.Power = spectrum[i]
+			}
+		}
+		p.Last = i - 1
+
+		peaks = append(peaks, p)
+	}
+
+	return peaks
+}
@@ -0,0 +1,126 @@
+package DSP
+
+import (
+	"math"
+)
+
+const (
+	// squelchReportInterval is the time between the status events of a closed squelch, in seconds
+	squelchReportInterval = 1.0
+)
+
+// SquelchEvent is a change of state of a squelch, or the periodic status of a closed one
+type SquelchEvent struct {
+	// Block is the position of the squelch block in the chain
+	Block int
+
+	Open bool
+
+	// Changed is false for the periodic status of a closed squelch
+	Changed bool
+
+	// Power is the average power of the input when the event happened, in dB
+	Power float32
+
+	// Delay is the time of the event from the start of the input buffer, in seconds
+	Delay float64
+}
+
+// PowerSquelch passes the IQ samples while their average power is above a threshold.
+// It opens at the threshold and closes when the power stays under threshold - hysteresis for the hang time.
+type PowerSquelch struct {
+	openLevel  float64
+	closeLevel float64
+	sampleRate float64
+
+	hangSamples   int
+	reportSamples int
+
+	power float64
+	open  bool
+
+	// hang counts the samples under the close level, sinceReport the samples since the last event
+	hang        int
+	sinceReport int
+
+	// leading and trailing count the samples of the last buffer held back before and after the first one passed
+	leading  int
+	trailing int
+}
+
+// MakePowerSquelch creates a closed squelch
+func MakePowerSquelch() *PowerSquelch {
+	return &PowerSquelch{}
+}
+
+// Configure sets the levels in dB and the hang time in seconds for samples at sampleRate, keeping the state
+func (s *PowerSquelch) Configure(threshold, hysteresis, hangTime, sampleRate float64) {
+	s.openLevel = math.Pow(10, threshold/10)
+	s.closeLevel = math.Pow(10, (threshold-math.Abs(hysteresis))/10)
+	s.sampleRate = sampleRate
+	s.hangSamples = int(hangTime * sampleRate)
+	s.reportSamples = int(squelchReportInterval * sampleRate)
+}
+
+// IsOpen returns whether the squelch is passing samples
+func (s *PowerSquelch) IsOpen() bool {
+	return s.open
+}
+
+// Work returns the samples received while open and the events that happened in the buffer
+func (s *PowerSquelch) Work(data []complex64) ([]complex64, []SquelchEvent) {
+	var out []complex64
+	var events []SquelchEvent
+
+	event := func(i int, changed bool) {
+		events = append(events, SquelchEvent{
+			Open:    s.open,
+			Changed: changed,
+			Power:   float32(10 * math.Log10(s.power+1e-20)),
+			Delay:   float64(i) / s.sampleRate,
+		})
+		s.sinceReport = 0
+	}
+
+	for i, v := range data {
+		s.power += squelchAlpha * (float64(real(v)*real(v)+imag(v)*imag(v)) - s.power)
+		s.sinceReport++
+
+		switch {
+		case !s.open && s.power >= s.openLevel:
+			s.open = true
+			s.hang = 0
+			event(i, true)
+		case s.open && s.power < s.closeLevel:
+			s.hang++
+			if s.hang > s.hangSamples {
+				s.open = false
+				event(i, true)
+			}
+		case s.open:
+			s.hang = 0
+		case s.sinceReport >= s.reportSamples:
+			event(i, false)
+		}
+
+		if s.open {
+			if len(out) == 0 {
+				s.leading = i
+			}
+			out = append(out, v)
+		}
+	}
+
+	if len(out) == 0 {
+		s.leading = 0
+	}
+	s.trailing = len(data) - len(out) - s.leading
+
+	return out, events
+}
+
+// Gated returns the samples of the last buffer held back before and after the first one passed,
+// all of them being trailing when none passed
+func (s *PowerSquelch) Gated() (leading, trailing int) {
+	return s.leading, s.trailing
+}
@@ -0,0 +1,126 @@
+package DSP
+
+import (
+	"math"
+	"testing"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+// level returns n samples of a carrier of power db
+func level(db float64, n int) []complex64 {
+	out := make([]complex64, n)
+	for i := range out {
+		out[i] = complex(float32(math.Pow(10, db/20)), 0)
+	}
+	return out
+}
+
+func TestPowerSquelch(t *testing.T) {
+	const sampleRate = 1000
+	const hang = 0.1
+
+	s := MakePowerSquelch()
+	s.Configure(-20, 6, hang, sampleRate)
+
+	tests := []struct {
+		name   string
+		db     float64
+		open   bool
+		events []bool
+	}{
+		{"under the threshold", -40, false, nil},
+		{"above the threshold", -10, true, []bool{true}},
+		{"within the hysteresis", -23, true, nil},
+		{"under the hysteresis", -40, false, []bool{false}},
+		{"back within the hysteresis", -23, false, nil},
+	}
+
+	for _, tt := range tests {
+		out, events := s.Work(level(tt.db, 500))
+
+		if s.IsOpen() != tt.open {
+			t.Errorf("%s: open is %v, want %v", tt.name, s.IsOpen(), tt.open)
+		}
+
+		var changes []SquelchEvent
+		for _, e := range events {
+			if e.Changed {
+				changes = append(changes, e)
+			}
+		}
+		if len(changes) != len(tt.events) {
+			t.Errorf("%s: %d changes, want %d", tt.name, len(changes), len(tt.events))
+			continue
+		}
+		for i, e := range changes {
+			if e.Open != tt.events[i] {
+				t.Errorf("%s: change %d opens %v, want %v", tt.name, i, e.Open, tt.events[i])
+			}
+		}
+
+		if len(changes) == 1 && !changes[0].Open {
+			// The squelch holds for the hang time once the power is under the close level
+			if changes[0].Delay < hang {
+				t.Errorf("%s: closed after %vs, before the hang time", tt.name, changes[0].Delay)
+			}
+			if len(out) != int(changes[0].Delay*sampleRate) {
+				t.Errorf("%s: passed %d samples, want the %d before closing", tt.name, len(out), int(changes[0].Delay*sampleRate))
+			}
+		}
+	}
+}
+
+func TestPowerSquelchReports(t *testing.T) {
+	s := MakePowerSquelch()
+	s.Configure(-20, 6, 0.1, 1000)
+
+	_, events := s.Work(level(-40, 3500))
+	if len(events) != 3 {
+		t.Fatalf("%d status events in 3.5s, want 3", len(events))
+	}
+	for _, e := range events {
+		if e.Changed || e.Open {
+			t.Errorf("unexpected event %+v of a closed squelch", e)
+		}
+	}
+}
+
+func TestChainCountsSquelchedSamples(t *testing.T) {
+	chain, err := MakeChain([]*protocol.BlockConfig{
+		{Type: protocol.BlockType_SquelchBlock, Threshold: -20, Hysteresis: 6, HangTime: 0.01},
+		{Type: protocol.BlockType_SinkBlock},
+		{Type: protocol.BlockType_DecimatorBlock, Decimation: 2},
+	}, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Closed, opening within the second buffer, closing within the third and closed again
+	buffers := []float64{-40, -10, -40, -40}
+	counted := make([]uint64, 2)
+	first := true
+	for i, db := range buffers {
+		outputs, _ := chain.Work(level(db, 1000))
+		for _, o := range outputs {
+			if o.Sink == 0 && first {
+				// The samples before the squelch opened come before the first output
+				if o.Gated <= 1000 || o.Gated >= 2000 {
+					t.Errorf("buffer %d: first output after %d held back samples, want some of the second buffer", i, o.Gated)
+				}
+				first = false
+			}
+			counted[o.Sink] += o.Gated + uint64(o.Len())
+		}
+	}
+
+	if first {
+		t.Fatal("the squelch never opened")
+	}
+	for sink, want := range []uint64{4000, 2000} {
+		total := counted[sink] + chain.Gated(uint32(sink))
+		if total+1 < want || total > want {
+			t.Errorf("sink %d counted %d samples, want %d", sink, total, want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+// commandStream sends commands on the Stream call of a session and matches their acknowledgements,
+// so that the tuning changes are ordered with the samples
+type commandStream struct {
+	stream protocol.RadioServer_StreamClient
+
+	lock    sync.Mutex
+	nextID  uint64
+	pending map[uint64]chan *protocol.StreamAck
+
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	// closing is set once the client ended its side of the stream
+	closing bool
+}
+
+func newCommandStream(stream protocol.RadioServer_StreamClient) *commandStream {
+	return &commandStream{
+		stream:  stream,
+		pending: map[uint64]chan *protocol.StreamAck{},
+		closed:  make(chan struct{}),
+	}
+}
+
+// send sends cmd and waits for its acknowledgement, failing when the server reports an error
+func (c *commandStream) send(ctx context.Context, cmd *protocol.StreamCommand) (*protocol.StreamAck, error) {
+	acked := make(chan *protocol.StreamAck, 1)
+
+	c.lock.Lock()
+	c.nextID++
+	cmd.ID = c.nextID
+	c.pending[cmd.ID] = acked
+	err := c.stream.Send(cmd)
+	c.lock.Unlock()
+
+	if err != nil {
+		c.forget(cmd.ID)
+		return nil, err
+	}
+
+	select {
+	case ack := <-acked:
+		if ack.Status == protocol.StatusType_Error {
+			return ack, fmt.Errorf("%s", ack.Error)
+		}
+		return ack, nil
+	case <-c.closed:
+		c.forget(cmd.ID)
+		return nil, fmt.Errorf("stream closed")
+	case <-ctx.Done():
+		c.forget(cmd.ID)
+		return nil, ctx.Err()
+	}
+}
+
+// acknowledge hands ack to the command waiting for it, reporting whether there was one
+func (c *commandStream) acknowledge(ack *protocol.StreamAck) bool {
+	c.lock.Lock()
+	acked := c.pending[ack.ID]
+	delete(c.pending, ack.ID)
+	c.lock.Unlock()
+
+	if acked == nil {
+		return false
+	}
+	acked <- ack
+	return true
+}
+
+func (c *commandStream) forget(id uint64) {
+	c.lock.Lock()
+	delete(c.pending, id)
+	c.lock.Unlock()
+}
+
+// closeSend ends the client side of the stream, the server then ends it after the pending messages
+func (c *commandStream) closeSend() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.closing = true
+	return c.stream.CloseSend()
+}
+
+// isClosing returns whether closeSend was called
+func (c *commandStream) isClosing() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.closing
+}
+
+// close fails the commands still waiting for an acknowledgement
+func (c *commandStream) close() {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+// DeviceFilter matches the devices a client can use
+type DeviceFilter func(*protocol.DeviceInfo) bool
+
+// BySerial matches the device with serial
+func BySerial(serial string) DeviceFilter {
+	return func(d *protocol.DeviceInfo) bool {
+		return d.Serial == serial
+	}
+}
+
+// ByName matches the devices of a model
+func ByName(name protocol.DeviceName) DeviceFilter {
+	return func(d *protocol.DeviceInfo) bool {
+		return d.Name == name
+	}
+}
+
+// ByFrequency matches the devices able to tune to frequency Hertz
+func ByFrequency(frequency uint32) DeviceFilter {
+	return func(d *protocol.DeviceInfo) bool {
+		return d.MinimumFrequency <= frequency && frequency <= d.MaximumFrequency
+	}
+}
+
+// BySampleRate matches the devices reaching sampleRate
+func BySampleRate(sampleRate uint32) DeviceFilter {
+	return func(d *protocol.DeviceInfo) bool {
+		return d.MaximumSampleRate >= sampleRate
+	}
+}
+
+// ByRXChannels matches the devices with at least channels receive channels
+func ByRXChannels(channels uint32) DeviceFilter {
+	return func(d *protocol.DeviceInfo) bool {
+		return d.MaximumRXChannels >= channels
+	}
+}
+
+// ListDevices returns the devices available on the server
+func (f *RadioClient) ListDevices() ([]*protocol.DeviceInfo, error) {
+	client, _ := f.connection()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	dls, err := client.List(f.ctx, &protocol.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing devices: %s", err)
+	}
+
+	return dls.Devices, nil
+}
+
+// SelectDevice returns the first device of the server matching every filter
+func (f *RadioClient) SelectDevice(filters ...DeviceFilter) (*protocol.DeviceInfo, error) {
+	devices, err := f.ListDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range devices {
+		if matchDevice(d, filters) {
+			return d, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no matching device among the %d of the server", len(devices))
+}
+
+func matchDevice(d *protocol.DeviceInfo, filters []DeviceFilter) bool {
+	for _, filter := range filters {
+		if !filter(d) {
+			return false
+		}
+	}
+	return true
+}
+
+// ConfigOption sets a field of a DeviceConfig.
+// The channel options apply to the first receive channel, the one streamed by RadioClient.
+type ConfigOption func(*protocol.DeviceConfig)
+
+// NewDeviceConfig builds a device configuration with a receive channel from opts
+func NewDeviceConfig(opts ...ConfigOption) *protocol.DeviceConfig {
+	c := &protocol.DeviceConfig{
+		RXC: []*protocol.ChannelConfig{{}},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// SampleRate sets the device sample rate in Hertz
+func SampleRate(sampleRate float32) ConfigOption {
+	return func(c *protocol.DeviceConfig) {
+		c.SampleRate = sampleRate
+	}
+}
+
+// Oversample sets the device oversampling ratio
+func Oversample(oversample uint32) ConfigOption {
+	return func(c *protocol.DeviceConfig) {
+		c.Oversample = oversample
+	}
+}
+
+// CenterFrequency sets the channel center frequency in Hertz
+func CenterFrequency(frequency float32) ConfigOption {
+	return func(c *protocol.DeviceConfig) {
+		c.RXC[0].CenterFrequency = frequency
+	}
+}
+
+// Gain sets the channel gain, from 0 to 1
+func Gain(gain float32) ConfigOption {
+	return func(c *protocol.DeviceConfig) {
+		c.RXC[0].NormalizedGain = gain
+	}
+}
+
+// Antenna sets the channel antenna
+func Antenna(antenna string) ConfigOption {
+	return func(c *protocol.DeviceConfig) {
+		c.RXC[0].Antenna = antenna
+	}
+}
+
+// AnalogFilterBandwidth sets the channel analog filter bandwidth in Hertz
+func AnalogFilterBandwidth(bandwidth float32) ConfigOption {
+	return func(c *protocol.DeviceConfig) {
+		c.RXC[0].AnalogFilterBandwidth = bandwidth
+	}
+}
+
+// DigitalFilterBandwidth sets the channel digital filter bandwidth in Hertz
+func DigitalFilterBandwidth(bandwidth float32) ConfigOption {
+	return func(c *protocol.DeviceConfig) {
+		c.RXC[0].DigitalFilterBandwidth = bandwidth
+	}
+}
+
+// DigitalGain sets the gain applied to the channel outputs, in dB
+func DigitalGain(gain float32) ConfigOption {
+	return func(c *protocol.DeviceConfig) {
+		c.RXC[0].DigitalGain = gain
+	}
+}
+
+// IQCorrection enables the DC and IQ imbalance corrections of the channel
+func IQCorrection(dc, iq bool) ConfigOption {
+	return func(c *protocol.DeviceConfig) {
+		c.RXC[0].DCCorrection = dc
+		c.RXC[0].IQCorrection = iq
+	}
+}
@@ -3,6 +3,11 @@ package client
 import (
   "context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
 	"github.com/quan-to/slog"
 	"github.com/luigifreitas/radioserver/protocol"
   "google.golang.org/grpc/encoding/gzip"
@@ -11,10 +16,50 @@ import (
 
 var log = slog.Scope("RadioClient")
 
+// iqStopTimeout is how long Stop waits for the server to end the IQ stream before cancelling it
+const iqStopTimeout = time.Second * 5
+
 type Callback interface {
 	OnData([]complex64)
 }
 
+// GapCallback can be implemented by a Callback to be notified about discontinuities in the IQ stream
+type GapCallback interface {
+	OnGap(Gap)
+}
+
+// OutputCallback can be implemented by a Callback to receive every output of the session processing chain.
+// OnData only receives the first output when it is IQ.
+type OutputCallback interface {
+	OnOutput(*protocol.IQData)
+}
+
+// SquelchCallback can be implemented by a Callback to receive the squelch events of the session processing chain.
+// While a squelch is closed no samples are sent, only a closed status every second.
+type SquelchCallback interface {
+	OnSquelch(*protocol.SquelchEvent)
+}
+
+// ErrorCallback can be implemented by a Callback to be notified about the connection and stream failures
+// happening out of a method call, like the IQ stream ending
+type ErrorCallback interface {
+	OnError(error)
+}
+
+// Gap describes samples lost between two IQ messages of a chain output
+type Gap struct {
+	// Sink is the chain output the gap happened on
+	Sink uint32
+	// SampleIndex is the index of the first sample after the gap
+	SampleIndex uint64
+	// Dropped is the total number of missing samples
+	Dropped uint64
+	// ServerDropped are the samples dropped by the server session queue
+	ServerDropped uint64
+	// FrontendDropped are the samples dropped between the frontend and the channel generator
+	FrontendDropped uint64
+}
+
 type RadioClient struct {
 	name           string
 	app            string
@@ -31,11 +76,32 @@ type RadioClient struct {
 	availableSampleRates   []uint32
 
 	iqChannelConfig      *protocol.ChannelConfig
-	iqChannelEnabled      bool
 
-	gain      uint32
-	streaming bool
-	cb        Callback
+	// streamLock guards iqChannelEnabled and streaming, and serializes starting and stopping the IQ loop
+	streamLock       sync.Mutex
+	iqChannelEnabled bool
+	streaming        bool
+	// iqCancel aborts the running IQ loop and iqDone is closed once it exited, both nil when there is none
+	iqCancel context.CancelFunc
+	iqDone   chan struct{}
+
+	cb Callback
+
+	reconnectPolicy ReconnectPolicy
+	state           ConnectionState
+	stateLock       sync.Mutex
+
+	// stop is closed by Disconnect to abort the reconnection
+	stop chan struct{}
+	// connLock guards conn, client, session and stop, which the reconnection replaces
+	connLock sync.Mutex
+
+	streams     map[*Stream]struct{}
+	streamsLock sync.RWMutex
+
+	// commands carries the tuning commands while the IQ stream runs, nil otherwise
+	commands     *commandStream
+	commandsLock sync.Mutex
 }
 
 func MakeRadioClient(address, name, application string) *RadioClient {
@@ -46,8 +112,6 @@ func MakeRadioClient(address, name, application string) *RadioClient {
 		routineRunning:        false,
 		availableSampleRates:  []uint32{},
 		iqChannelConfig:       &protocol.ChannelConfig{},
-		iqChannelEnabled:      false,
-		streaming:             false,
     currentSampleRate:     600000,
     ctx:                   context.Background(),
   }
@@ -55,145 +119,417 @@ func MakeRadioClient(address, name, application string) *RadioClient {
 
 // region Public Methods
 
-// GetName returns the name of the active device in RadioClient
+// GetName returns the name of the active device in RadioClient, or an empty string when not connected
 func (f *RadioClient) GetName() string {
+	if f.deviceState == nil || f.deviceState.Info == nil {
+		return ""
+	}
 	return f.deviceState.Info.Name.String()
 }
 
-// Start starts the streaming process (if not already started)
-func (f *RadioClient) Start() {
+// Start starts the streaming process (if not already started).
+// Failures of the stream after it started go to the ErrorCallback.
+func (f *RadioClient) Start() error {
+	if _, session := f.connection(); session == nil {
+		return fmt.Errorf("no device provisioned")
+	}
+
+	f.streamLock.Lock()
+	defer f.streamLock.Unlock()
+
 	if !f.streaming {
 		log.Debug("Starting streaming")
 		f.streaming = true
-		f.setStreamState()
+		f.startIQLoop()
 	}
+	return nil
 }
 
-// Stop stop the streaming process (if started)
-func (f *RadioClient) Stop() {
+// Stop stops the streaming process (if started) and closes the open streams, returning once the IQ stream ended.
+// It must not be called from a Callback, which runs on the IQ stream.
+func (f *RadioClient) Stop() error {
+	f.streamLock.Lock()
+	defer f.streamLock.Unlock()
+
 	if f.streaming {
 		log.Debug("Stopping")
 		f.streaming = false
-		f.setStreamState()
+		f.stopIQLoop()
 	}
+	return nil
 }
 
-func (f *RadioClient) setStreamState() {
-	if f.streaming {
-		if f.iqChannelEnabled {
-			go f.iqLoop()
-		}
+// startIQLoop runs the IQ loop when streaming with the IQ channel enabled and it isn't running yet.
+// The stream lock must be held.
+func (f *RadioClient) startIQLoop() {
+	if !f.streaming || !f.iqChannelEnabled || f.iqDone != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(f.ctx)
+	done := make(chan struct{})
+	f.iqCancel, f.iqDone = cancel, done
+
+	go func() {
+		err := f.iqLoop(ctx)
+		cancel()
+		close(done)
+		f.iqLoopEnded(done, err)
+	}()
+}
+
+// stopIQLoop ends the IQ loop, if running, and the streams it feeds, and waits for it to exit.
+// Ending the commands first lets the server close the stream and keep the session, the loop is cancelled
+// if it doesn't in time. The stream lock must be held.
+func (f *RadioClient) stopIQLoop() {
+	if f.iqDone == nil {
+		return
+	}
+
+	cancel, done := f.iqCancel, f.iqDone
+	f.iqCancel, f.iqDone = nil, nil
+
+	// Closing the streams unblocks the loop if it waits for a consumer
+	f.closeStreams(nil)
+
+	if c := f.commandStream(); c == nil || c.closeSend() != nil {
+		cancel()
+	}
+
+	select {
+	case <-done:
+	case <-time.After(iqStopTimeout):
+		log.Warn("IQ stream not ended by the server, cancelling it")
+		cancel()
+		<-done
 	}
 }
 
-func (f *RadioClient) iqLoop() {
-	iqClient, err := f.client.RXIQ(f.ctx, f.session)
+// iqLoop runs the IQ stream of the session on a Stream call, which also carries the tuning commands,
+// until ctx is done or its commands are closed. It returns the failure of the stream, if any.
+func (f *RadioClient) iqLoop(ctx context.Context) error {
+	client, session := f.connection()
+	if client == nil || session == nil {
+		return nil
+	}
 
+	stream, err := client.Stream(ctx)
+	if err == nil {
+		err = stream.Send(&protocol.StreamCommand{
+			Type:    protocol.CommandType_StartIQ,
+			Session: session,
+		})
+	}
 	if err != nil {
-		log.Fatal(err)
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("error starting the IQ stream: %s", err)
 	}
-	for f.iqChannelEnabled {
-		data, err := iqClient.Recv()
+
+	commands := newCommandStream(stream)
+	f.setCommandStream(commands)
+	defer func() {
+		f.setCommandStream(nil)
+		commands.close()
+	}()
+
+	nextIndex := map[uint32]uint64{}
+	for {
+		msg, err := stream.Recv()
 		if err != nil {
-			log.Error(err)
-			f.iqChannelEnabled = false
-			break
+			if ctx.Err() != nil || (err == io.EOF && commands.isClosing()) {
+				return nil
+			}
+			return fmt.Errorf("IQ stream failed: %s", err)
 		}
-		cData := data.GetComplexSamples()
-		if f.cb != nil {
-			f.cb.OnData(cData)
+
+		if msg.Ack != nil && !commands.acknowledge(msg.Ack) && msg.Ack.Status == protocol.StatusType_Error {
+			f.notifyError(fmt.Errorf("server refused %s: %s", msg.Ack.Type, msg.Ack.Error))
+		}
+		if msg.Status != nil && msg.Status.Status == protocol.StatusType_Error {
+			return fmt.Errorf("IQ stream ended by the server: %s", msg.Status.Message)
+		}
+		if msg.IQ != nil {
+			f.handleIQ(msg.IQ, nextIndex)
 		}
 	}
 }
 
-// Connect initiates the connection with RadioClient.
-// It panics if the connection fails for some reason.
-func (f *RadioClient) Connect() {
-	if f.routineRunning {
+// handleIQ hands an output of the session chain to the callbacks and streams.
+// nextIndex holds the index expected next on each output, to detect the gaps.
+func (f *RadioClient) handleIQ(data *protocol.IQData, nextIndex map[uint32]uint64) {
+	if data.Squelch != nil {
+		if cb, ok := f.cb.(SquelchCallback); ok {
+			cb.OnSquelch(data.Squelch)
+		}
 		return
 	}
 
+	numSamples := uint64(len(data.Samples))
+	if data.Kind == protocol.SampleKind_IQSamples {
+		numSamples /= 2
+	}
+
+	// The samples held back by a squelch move the index without being lost
+	expected, seen := nextIndex[data.Sink]
+	expected += data.Squelched
+	if seen && (data.SampleIndex != expected || data.ServerDropped > 0 || data.FrontendDropped > 0) {
+		f.notifyGap(Gap{
+			Sink:            data.Sink,
+			SampleIndex:     data.SampleIndex,
+			Dropped:         data.SampleIndex - expected,
+			ServerDropped:   data.ServerDropped,
+			FrontendDropped: data.FrontendDropped,
+		})
+	}
+	nextIndex[data.Sink] = data.SampleIndex + numSamples
+
+	if cb, ok := f.cb.(OutputCallback); ok {
+		cb.OnOutput(data)
+	}
+
+	if data.Sink == 0 && data.Kind == protocol.SampleKind_IQSamples && (f.cb != nil || f.hasStreams()) {
+		samples := data.GetComplexSamples()
+		if f.cb != nil {
+			f.cb.OnData(samples)
+		}
+		f.pushStreams(samples)
+	}
+}
+
+func (f *RadioClient) setCommandStream(c *commandStream) {
+	f.commandsLock.Lock()
+	f.commands = c
+	f.commandsLock.Unlock()
+}
+
+// commandStream returns the stream carrying the commands while the IQ stream runs, nil otherwise
+func (f *RadioClient) commandStream() *commandStream {
+	f.commandsLock.Lock()
+	defer f.commandsLock.Unlock()
+	return f.commands
+}
+
+func (f *RadioClient) notifyGap(gap Gap) {
+	log.Warn("Gap of %d samples at %d on output %d", gap.Dropped, gap.SampleIndex, gap.Sink)
+	if cb, ok := f.cb.(GapCallback); ok {
+		cb.OnGap(gap)
+	}
+}
+
+// notifyError logs err and hands it to the ErrorCallback, if any
+func (f *RadioClient) notifyError(err error) {
+	log.Error("%s", err)
+	if cb, ok := f.cb.(ErrorCallback); ok {
+		cb.OnError(err)
+	}
+}
+
+// Connect initiates the connection with the server.
+// A device is then picked with ListDevices or SelectDevice and set up with Provision.
+func (f *RadioClient) Connect() error {
+	if client, _ := f.connection(); client != nil {
+		return nil
+	}
+
+	conn, client, err := f.dial()
+	if err != nil {
+		return err
+	}
+
+	f.connLock.Lock()
+	f.conn = conn
+	f.client = client
+	f.stop = make(chan struct{})
+	f.connLock.Unlock()
+
+	f.terminated = false
+	f.setState(Connected, nil)
+
+	return nil
+}
+
+// connection returns the client of the current connection and its session, nil when there is none
+func (f *RadioClient) connection() (protocol.RadioServerClient, *protocol.Session) {
+	f.connLock.Lock()
+	defer f.connLock.Unlock()
+	return f.client, f.session
+}
+
+// dial opens a connection to the server and fetches its info, leaving the current connection untouched
+func (f *RadioClient) dial() (*grpc.ClientConn, protocol.RadioServerClient, error) {
 	log.Debug("Trying to connect")
 
 	var opts []grpc.DialOption
 	opts = append(opts, grpc.WithInsecure())
-  opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
 	conn, err := grpc.Dial(f.address, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error connecting to %s: %s", f.address, err)
+	}
 
+	client := protocol.NewRadioServerClient(conn)
+
+	log.Debug("Fetching server info")
+	sinf, err := client.ServerInfo(f.ctx, &protocol.Empty{})
 	if err != nil {
-		log.Fatal(err)
+		_ = conn.Close()
+		return nil, nil, fmt.Errorf("error fetching server info: %s", err)
 	}
 
-	f.conn = conn
+	f.serverInfo = sinf
 
-	f.client = protocol.NewRadioServerClient(conn)
+	return conn, client, nil
+}
 
-  log.Debug("Connected, listing devices.")
-	dls, err := f.client.List(f.ctx, &protocol.Empty{})
-	if err != nil {
-		log.Fatal(err)
+// Provision opens device on the server with config, built with NewDeviceConfig.
+// The first channel is streamed at the client sample rate, or at the device one when config has no sample rate.
+func (f *RadioClient) Provision(device *protocol.DeviceInfo, config *protocol.DeviceConfig) error {
+	client, current := f.connection()
+	if client == nil {
+		return fmt.Errorf("not connected")
 	}
 
-  i := protocol.DeviceState{
-    Info: dls.Devices[0],
-    Config: &protocol.DeviceConfig{
-      SampleRate: float32(f.currentSampleRate),
-      Oversample: 16,
-    },
-  }
+	if current != nil {
+		return fmt.Errorf("a device is already provisioned")
+	}
 
-  i.Config.RXC = append(i.Config.RXC,
-    &protocol.ChannelConfig{
-      CenterFrequency: 96.9e6,
-      NormalizedGain: 0.5,
-      Antenna: "LNAW",
-    })
+	if device == nil || config == nil || len(config.RXC) == 0 {
+		return fmt.Errorf("provisioning needs a device and a configuration with a receive channel")
+	}
 
-  deviceProv, _ := json.MarshalIndent(i, "", "   ")
-	log.Info("Provisioning Device: %s", deviceProv)
+	if config.SampleRate == 0 {
+		config.SampleRate = float32(f.currentSampleRate)
+	}
 
-  session, err := f.client.Provision(f.ctx, &i)
-	if err != nil {
-		log.Fatal(err)
+	i := protocol.DeviceState{
+		Info:   device,
+		Config: config,
+		Chain:  f.chain(),
 	}
 
-  f.session = session
-  f.deviceState = &i
+	deviceProv, _ := json.MarshalIndent(i, "", "   ")
+	log.Info("Provisioning Device: %s", deviceProv)
 
-	log.Debug("Fetching server info")
-	sinf, err := f.client.ServerInfo(f.ctx, &protocol.Empty{})
+	session, err := client.Provision(f.ctx, &i)
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("error provisioning device: %s", err)
 	}
 
-  f.serverInfo = sinf
+	f.connLock.Lock()
+	f.session = session
+	f.connLock.Unlock()
+	f.deviceState = &i
+	f.iqChannelConfig = config.RXC[0]
+
+	return nil
+}
+
+// GetServerInfo returns the name and version of the connected server
+func (f *RadioClient) GetServerInfo() *protocol.ServerInfoData {
+	return f.serverInfo
 }
 
-func (f *RadioClient) ChangeFrequency(cf float32) {
-  f.deviceState.Config.RXC[0].CenterFrequency = cf
+// ChangeFrequency retunes the first channel of the device to cf Hertz
+func (f *RadioClient) ChangeFrequency(cf float32) error {
+	_, err := f.tune(func(c *protocol.ChannelConfig) {
+		c.CenterFrequency = cf
+	})
+	return err
+}
+
+// tune sends the device configuration with its first channel changed by update to the server,
+// keeping the configuration the server applied. While the IQ stream runs the change goes on it.
+func (f *RadioClient) tune(update func(*protocol.ChannelConfig)) (*protocol.ChannelConfig, error) {
+	client, session := f.connection()
+	if session == nil {
+		return nil, fmt.Errorf("no device provisioned")
+	}
 
-  deviceProv, _ := json.MarshalIndent(f.deviceState, "", "   ")
+	config := *f.deviceState.Config
+	config.RXC = append([]*protocol.ChannelConfig(nil), config.RXC...)
+	channel := *config.RXC[0]
+	update(&channel)
+	config.RXC[0] = &channel
+
+	deviceProv, _ := json.MarshalIndent(config, "", "   ")
 	log.Info("Retuning Device: %s", deviceProv)
 
-  _, err := f.client.Tune(f.ctx, &protocol.DeviceTune{
-    Session: f.session,
-    Config: f.deviceState.Config,
-  })
-	if err != nil {
-		log.Fatal(err)
+	var applied *protocol.DeviceConfig
+	if c := f.commandStream(); c != nil {
+		ack, err := c.send(f.ctx, &protocol.StreamCommand{
+			Type:          protocol.CommandType_SetChannel,
+			ChannelConfig: &channel,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error tuning device: %s", err)
+		}
+		applied = ack.Config
+	} else {
+		var err error
+		applied, err = client.Tune(f.ctx, &protocol.DeviceTune{
+			Session: session,
+			Config:  &config,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error tuning device: %s", err)
+		}
+	}
+	if applied == nil || len(applied.RXC) == 0 {
+		return nil, fmt.Errorf("server applied no receive channel")
 	}
+
+	f.deviceState.Config = applied
+	f.iqChannelConfig = applied.RXC[0]
+
+	return f.iqChannelConfig, nil
 }
 
 // Disconnect disconnects from current connected RadioClient.
-func (f *RadioClient) Disconnect() {
+func (f *RadioClient) Disconnect() error {
 	log.Debug("Disconnecting")
 	f.terminated = true
+	f.routineRunning = false
+
+	f.streamLock.Lock()
 	f.iqChannelEnabled = false
+	f.stopIQLoop()
+	f.streamLock.Unlock()
 
-	if f.conn != nil {
-		_ = f.conn.Close()
+	f.connLock.Lock()
+	if f.stop != nil {
+		close(f.stop)
+		f.stop = nil
 	}
-	f.routineRunning = false
+	conn, client, session := f.conn, f.client, f.session
+	f.conn = nil
+	f.client = nil
+	f.session = nil
+	f.connLock.Unlock()
+
+	f.closeStreams(nil)
+	defer f.setState(Disconnected, nil)
+
+	if conn == nil {
+		return nil
+	}
+
+	var err error
+	if session != nil {
+		if _, e := client.Destroy(f.ctx, session); e != nil {
+			err = fmt.Errorf("error destroying session: %s", e)
+		}
+	}
+
+	if e := conn.Close(); e != nil && err == nil {
+		err = e
+	}
+
+	f.deviceState = nil
+	f.iqChannelConfig = &protocol.ChannelConfig{}
+
+	return err
 }
 
 // GetSampleRate returns the sample rate of the IQ channel in Hertz
@@ -201,12 +537,58 @@ func (f *RadioClient) GetSampleRate() uint32 {
 	return f.currentSampleRate
 }
 
-// SetSampleRate sets the sample rate of the IQ Channel in Hertz
-// Check the available sample rates using GetAvailableSampleRates
-// Returns Invalid in case of a invalid value in the input
-func (f *RadioClient) SetSampleRate(sampleRate uint32) uint32 {
-  f.currentSampleRate = sampleRate
-	return f.currentSampleRate
+// SetSampleRate sets the sample rate of the IQ Channel in Hertz.
+// The server resamples the device output to it and the delivered rate is returned.
+func (f *RadioClient) SetSampleRate(sampleRate uint32) (uint32, error) {
+	client, session := f.connection()
+	if session == nil {
+		f.currentSampleRate = sampleRate
+		return f.currentSampleRate, nil
+	}
+
+	previous := f.currentSampleRate
+	f.currentSampleRate = sampleRate
+
+	var rates []float32
+	chain := f.chain()
+	if c := f.commandStream(); c != nil {
+		ack, err := c.send(f.ctx, &protocol.StreamCommand{
+			Type:  protocol.CommandType_SetChain,
+			Chain: chain,
+		})
+		if err != nil {
+			f.currentSampleRate = previous
+			return 0, fmt.Errorf("error setting sample rate to %d: %s", sampleRate, err)
+		}
+		rates = ack.SinkRates
+	} else {
+		c, err := client.ConfigureChain(f.ctx, &protocol.ProcessingChain{
+			Session: session,
+			Blocks:  chain,
+		})
+		if err != nil {
+			f.currentSampleRate = previous
+			return 0, fmt.Errorf("error setting sample rate to %d: %s", sampleRate, err)
+		}
+		rates = c.SinkRates
+	}
+	if len(rates) == 0 {
+		return 0, fmt.Errorf("server reported no output rate")
+	}
+
+	f.currentSampleRate = uint32(math.Round(float64(rates[0])))
+	f.deviceState.Chain = chain
+	return f.currentSampleRate, nil
+}
+
+// chain returns the processing chain delivering the IQ channel at the current sample rate
+func (f *RadioClient) chain() []*protocol.BlockConfig {
+	return []*protocol.BlockConfig{
+		{
+			Type: protocol.BlockType_ResamplerBlock,
+			Rate: float64(f.currentSampleRate),
+		},
+	}
 }
 
 // GetCenterFrequency returns the IQ Channel Center Frequency in Hz
@@ -214,16 +596,33 @@ func (f *RadioClient) GetCenterFrequency() uint32 {
 	return uint32(f.iqChannelConfig.CenterFrequency)
 }
 
-// SetCenterFrequency sets the IQ Channel Center Frequency in Hertz and returns it.
-func (f *RadioClient) SetCenterFrequency(centerFrequency uint32) uint32 {
-	return 0//f.iqChannelConfig.CenterFrequency
+// SetCenterFrequency sets the IQ Channel Center Frequency in Hertz and returns the one applied by the server.
+func (f *RadioClient) SetCenterFrequency(centerFrequency uint32) (uint32, error) {
+	c, err := f.tune(func(c *protocol.ChannelConfig) {
+		c.CenterFrequency = float32(centerFrequency)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return uint32(c.CenterFrequency), nil
 }
 
+// SetIQEnabled switches the IQ stream, which runs while both enabled and started
 func (f *RadioClient) SetIQEnabled(iqEnabled bool) {
+	f.streamLock.Lock()
+	defer f.streamLock.Unlock()
+
 	f.iqChannelEnabled = iqEnabled
+	if iqEnabled {
+		f.startIQLoop()
+	} else {
+		f.stopIQLoop()
+	}
 }
 
-// SetCallback sets the callbacks for server data
+// SetCallback sets the callbacks for server data.
+// If cb also implements GapCallback it is notified about the gaps in the IQ stream,
+// if it implements SquelchCallback about the squelch events and if it implements ErrorCallback about the failures.
 func (f *RadioClient) SetCallback(cb Callback) {
 	f.cb = cb
 }
@@ -233,17 +632,75 @@ func (f *RadioClient) GetAvailableSampleRates() []uint32 {
 	return f.availableSampleRates
 }
 
-// SetGain sets the gain stage of the server.
+// SetGain sets the gain of the IQ Channel, from 0 to 1, and returns the one applied by the server.
 // The actual gain in dB varies from device to device.
-// Returns Invalid in case of a invalid value in the input
-func (f *RadioClient) SetGain(gain uint32) uint32 {
-	f.gain = gain
-	return gain
+func (f *RadioClient) SetGain(gain float32) (float32, error) {
+	if gain < 0 || gain > 1 {
+		return 0, fmt.Errorf("gain should be between 0 and 1")
+	}
+
+	c, err := f.tune(func(c *protocol.ChannelConfig) {
+		c.NormalizedGain = gain
+	})
+	if err != nil {
+		return 0, err
+	}
+	return c.NormalizedGain, nil
+}
+
+// GetGain returns the gain of the IQ Channel, from 0 to 1.
+func (f *RadioClient) GetGain() float32 {
+	return f.iqChannelConfig.NormalizedGain
+}
+
+// SetAntenna selects the antenna of the IQ Channel and returns the one applied by the server.
+func (f *RadioClient) SetAntenna(antenna string) (string, error) {
+	c, err := f.tune(func(c *protocol.ChannelConfig) {
+		c.Antenna = antenna
+	})
+	if err != nil {
+		return "", err
+	}
+	return c.Antenna, nil
+}
+
+// GetAntenna returns the antenna of the IQ Channel.
+func (f *RadioClient) GetAntenna() string {
+	return f.iqChannelConfig.Antenna
+}
+
+// SetAnalogFilterBandwidth sets the analog filter bandwidth of the IQ Channel in Hertz
+// and returns the one applied by the server.
+func (f *RadioClient) SetAnalogFilterBandwidth(bandwidth float32) (float32, error) {
+	c, err := f.tune(func(c *protocol.ChannelConfig) {
+		c.AnalogFilterBandwidth = bandwidth
+	})
+	if err != nil {
+		return 0, err
+	}
+	return c.AnalogFilterBandwidth, nil
+}
+
+// GetAnalogFilterBandwidth returns the analog filter bandwidth of the IQ Channel in Hertz.
+func (f *RadioClient) GetAnalogFilterBandwidth() float32 {
+	return f.iqChannelConfig.AnalogFilterBandwidth
+}
+
+// SetDigitalFilterBandwidth sets the digital filter bandwidth of the IQ Channel in Hertz, zero disables it.
+// Returns the one applied by the server.
+func (f *RadioClient) SetDigitalFilterBandwidth(bandwidth float32) (float32, error) {
+	c, err := f.tune(func(c *protocol.ChannelConfig) {
+		c.DigitalFilterBandwidth = bandwidth
+	})
+	if err != nil {
+		return 0, err
+	}
+	return c.DigitalFilterBandwidth, nil
 }
 
-// GetGain returns the current gain stage of the server.
-func (f *RadioClient) GetGain() uint32 {
-	return f.gain
+// GetDigitalFilterBandwidth returns the digital filter bandwidth of the IQ Channel in Hertz.
+func (f *RadioClient) GetDigitalFilterBandwidth() float32 {
+	return f.iqChannelConfig.DigitalFilterBandwidth
 }
 
 // endregion
@@ -0,0 +1,233 @@
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/luigifreitas/radioserver/frontends"
+	"github.com/luigifreitas/radioserver/protocol"
+	"github.com/luigifreitas/radioserver/server"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	frontends.Available[protocol.DeviceName_TestSignal.String()] = newToneFrontend
+}
+
+// toneFrontend is a frontend without hardware delivering a constant tone every few milliseconds while started
+type toneFrontend struct {
+	lock   sync.Mutex
+	info   protocol.DeviceInfo
+	config protocol.DeviceConfig
+	cb     frontends.SamplesCallback
+	stop   chan struct{}
+}
+
+func newToneFrontend(state *protocol.DeviceState) frontends.Frontend {
+	f := &toneFrontend{info: *state.Info}
+	f.SetDeviceConfig(state.Config)
+	return f
+}
+
+func (f *toneFrontend) GetDeviceInfo() protocol.DeviceInfo {
+	return f.info
+}
+
+func (f *toneFrontend) GetDeviceConfig() protocol.DeviceConfig {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.config
+}
+
+func (f *toneFrontend) SetDeviceConfig(c *protocol.DeviceConfig) protocol.DeviceConfig {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.config = *c
+	return f.config
+}
+
+func (f *toneFrontend) Init() bool { return true }
+
+func (f *toneFrontend) Start() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.stop != nil {
+		return
+	}
+
+	stop := make(chan struct{})
+	f.stop = stop
+	go func() {
+		ticker := time.NewTicker(time.Millisecond * 10)
+		defer ticker.Stop()
+
+		index := uint64(0)
+		for {
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+
+			samples := make([]complex64, 480)
+			for i := range samples {
+				samples[i] = complex(0.5, 0.25)
+			}
+			f.cb(samples, index)
+			index += uint64(len(samples))
+		}
+	}()
+}
+
+func (f *toneFrontend) Stop() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.stop != nil {
+		close(f.stop)
+		f.stop = nil
+	}
+}
+
+func (f *toneFrontend) Destroy() {}
+
+func (f *toneFrontend) SetSamplesAvailableCallback(cb frontends.SamplesCallback) {
+	f.cb = cb
+}
+
+// testServer serves a radio server on a local port, returning its address and a function stopping it
+func testServer(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "radioclient")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs := server.MakeRadioServer("test")
+	rs.SetRecordingsPath(filepath.Join(dir, "recordings"))
+	rs.SetStatePath(filepath.Join(dir, "state"))
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := grpc.NewServer()
+	protocol.RegisterRadioServerServer(g, rs)
+	go func() {
+		_ = g.Serve(lis)
+	}()
+
+	return lis.Addr().String(), func() {
+		g.Stop()
+		_ = os.RemoveAll(dir)
+	}
+}
+
+// errorRecorder keeps the failures reported to the client callbacks
+type errorRecorder struct {
+	lock   sync.Mutex
+	errors []error
+}
+
+func (r *errorRecorder) OnData([]complex64) {}
+
+func (r *errorRecorder) OnError(err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.errors = append(r.errors, err)
+}
+
+func (r *errorRecorder) reported() []error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]error(nil), r.errors...)
+}
+
+// receive waits for a block of samples on s
+func receive(t *testing.T, s *Stream) {
+	select {
+	case samples, ok := <-s.Samples():
+		if !ok {
+			t.Fatalf("stream closed: %v", s.Err())
+		}
+		if len(samples) == 0 {
+			t.Fatal("empty block of samples")
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("timeout waiting for samples")
+	}
+}
+
+func TestStopStart(t *testing.T) {
+	address, cleanup := testServer(t)
+	defer cleanup()
+
+	c := MakeRadioClient(address, "test", "test")
+	errors := &errorRecorder{}
+	c.SetCallback(errors)
+	if _, err := c.SetSampleRate(48000); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Disconnect()
+
+	device := &protocol.DeviceInfo{Name: protocol.DeviceName_TestSignal, Serial: "fake"}
+	if err := c.Provision(device, NewDeviceConfig(SampleRate(48000), CenterFrequency(100e6))); err != nil {
+		t.Fatal(err)
+	}
+
+	for round := 0; round < 3; round++ {
+		s, err := c.OpenStream(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		receive(t, s)
+
+		// Tuning goes on the running stream
+		if err := c.ChangeFrequency(float32(101e6 + round*1e6)); err != nil {
+			t.Errorf("round %d: %s", round, err)
+		}
+
+		if err := c.Stop(); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.Stop(); err != nil {
+			t.Errorf("round %d: stopping twice: %s", round, err)
+		}
+
+		// Stop ends the streams and the loop feeding them
+		for range s.Samples() {
+		}
+		if s.Err() != nil {
+			t.Errorf("round %d: stream closed with %s", round, s.Err())
+		}
+		if c.commandStream() != nil {
+			t.Errorf("round %d: IQ loop still running after Stop", round)
+		}
+
+		if err := c.Start(); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.Start(); err != nil {
+			t.Errorf("round %d: starting twice: %s", round, err)
+		}
+		if err := c.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if c.State() != Connected {
+		t.Errorf("client %s after stopping and starting, want connected", c.State())
+	}
+	if errs := errors.reported(); len(errs) > 0 {
+		t.Errorf("failures reported: %v", errs)
+	}
+}
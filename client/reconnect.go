@@ -0,0 +1,247 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+// ConnectionState is the state of the connection of a RadioClient to its server
+type ConnectionState int
+
+const (
+	Disconnected ConnectionState = iota
+	Connected
+	Reconnecting
+)
+
+func (s ConnectionState) String() string {
+	switch s {
+	case Disconnected:
+		return "Disconnected"
+	case Connected:
+		return "Connected"
+	case Reconnecting:
+		return "Reconnecting"
+	}
+	return fmt.Sprintf("ConnectionState(%d)", int(s))
+}
+
+// ConnectionCallback can be implemented by a Callback to be notified about the connection state changes.
+// err is the failure that caused the change, if any.
+type ConnectionCallback interface {
+	OnConnectionState(state ConnectionState, err error)
+}
+
+// ReconnectPolicy sets how a RadioClient recovers when the connection or the IQ stream fails
+type ReconnectPolicy struct {
+	Enabled bool
+
+	// InitialDelay is the wait before the first attempt, multiplied by Multiplier after each failure up to MaxDelay
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+	Multiplier   float64
+
+	// MaxAttempts gives up after that many failed attempts, zero retries forever
+	MaxAttempts int
+}
+
+// DefaultReconnectPolicy retries forever, waiting from half a second up to a minute between attempts
+var DefaultReconnectPolicy = ReconnectPolicy{
+	Enabled:      true,
+	InitialDelay: time.Millisecond * 500,
+	MaxDelay:     time.Minute,
+	Multiplier:   2,
+}
+
+// SetReconnectPolicy sets how the client recovers from failures. Reconnection is disabled by default.
+func (f *RadioClient) SetReconnectPolicy(policy ReconnectPolicy) {
+	f.reconnectPolicy = policy
+}
+
+// State returns the state of the connection to the server
+func (f *RadioClient) State() ConnectionState {
+	f.stateLock.Lock()
+	defer f.stateLock.Unlock()
+	return f.state
+}
+
+func (f *RadioClient) setState(state ConnectionState, err error) {
+	f.stateLock.Lock()
+	changed := f.state != state
+	f.state = state
+	f.stateLock.Unlock()
+
+	if !changed {
+		return
+	}
+
+	log.Info("Connection %s", state)
+	if cb, ok := f.cb.(ConnectionCallback); ok {
+		cb.OnConnectionState(state, err)
+	}
+}
+
+// iqLoopEnded releases the IQ loop that closed done and handles its failure, if any, reconnecting when
+// the policy allows it. A loop already released by Stop is ignored.
+func (f *RadioClient) iqLoopEnded(done chan struct{}, err error) {
+	f.streamLock.Lock()
+	current := f.iqDone == done
+	if current {
+		f.iqCancel, f.iqDone = nil, nil
+	}
+	giveUp := current && err != nil && !f.terminated && !f.reconnectPolicy.Enabled
+	if giveUp {
+		f.iqChannelEnabled = false
+		f.streaming = false
+	}
+	f.streamLock.Unlock()
+
+	if !current || err == nil {
+		return
+	}
+
+	f.notifyError(err)
+
+	if f.terminated {
+		return
+	}
+
+	if giveUp {
+		f.closeStreams(err)
+		f.setState(Disconnected, err)
+		return
+	}
+
+	f.setState(Reconnecting, err)
+	go f.reconnect()
+}
+
+// reconnect connects again with backoff, provisions the last device state and restarts the IQ stream
+func (f *RadioClient) reconnect() {
+	// Disconnect closes and clears f.stop, so it is read once
+	f.connLock.Lock()
+	stop := f.stop
+	f.connLock.Unlock()
+	if stop == nil {
+		return
+	}
+
+	p := f.reconnectPolicy
+	delay := p.InitialDelay
+
+	for attempt := 1; p.MaxAttempts == 0 || attempt <= p.MaxAttempts; attempt++ {
+		select {
+		case <-time.After(delay):
+		case <-stop:
+			return
+		}
+
+		err := f.restore(stop)
+		if err == errDisconnected {
+			return
+		}
+		if err == nil {
+			log.Info("Reconnected after %d attempts", attempt)
+			f.setState(Connected, nil)
+
+			f.streamLock.Lock()
+			f.startIQLoop()
+			f.streamLock.Unlock()
+			return
+		}
+
+		log.Warn("Reconnection attempt %d failed: %s", attempt, err)
+
+		delay = time.Duration(float64(delay) * p.Multiplier)
+		if delay > p.MaxDelay {
+			delay = p.MaxDelay
+		}
+	}
+
+	err := fmt.Errorf("giving up reconnecting after %d attempts", p.MaxAttempts)
+	f.streamLock.Lock()
+	f.iqChannelEnabled = false
+	f.streaming = false
+	f.streamLock.Unlock()
+	f.notifyError(err)
+	f.closeStreams(err)
+	f.setState(Disconnected, err)
+}
+
+// errDisconnected is returned by restore when Disconnect was called meanwhile
+var errDisconnected = fmt.Errorf("disconnected")
+
+// restore opens a new connection and provisions the last device state on it, applying its tuning.
+// The new connection replaces the current one unless stop was closed meanwhile.
+func (f *RadioClient) restore(stop chan struct{}) error {
+	state := f.deviceState
+
+	f.connLock.Lock()
+	stale := f.session
+	f.connLock.Unlock()
+
+	conn, client, err := f.dial()
+	if err != nil {
+		return err
+	}
+
+	var session *protocol.Session
+	if state != nil {
+		session, err = f.provisionAgain(client, stale, state)
+		if err != nil {
+			_ = conn.Close()
+			return err
+		}
+	}
+
+	f.connLock.Lock()
+	select {
+	case <-stop:
+		f.connLock.Unlock()
+		if session != nil {
+			_, _ = client.Destroy(f.ctx, session)
+		}
+		_ = conn.Close()
+		return errDisconnected
+	default:
+	}
+
+	previous := f.conn
+	f.conn = conn
+	f.client = client
+	f.session = session
+	f.connLock.Unlock()
+
+	if previous != nil {
+		_ = previous.Close()
+	}
+	return nil
+}
+
+// provisionAgain provisions state in a new session, destroying the stale session first since the server
+// keeps it, holding the device, until it expires when only the connection failed
+func (f *RadioClient) provisionAgain(client protocol.RadioServerClient, stale *protocol.Session, state *protocol.DeviceState) (*protocol.Session, error) {
+	if stale != nil {
+		if _, err := client.Destroy(f.ctx, stale); err != nil {
+			log.Debug("Stale session not destroyed: %s", err)
+		}
+	}
+
+	session, err := client.Provision(f.ctx, state)
+	if err != nil {
+		return nil, fmt.Errorf("error provisioning device: %s", err)
+	}
+
+	_, err = client.Tune(f.ctx, &protocol.DeviceTune{
+		Session: session,
+		Config:  state.Config,
+	})
+	if err != nil {
+		_, _ = client.Destroy(f.ctx, session)
+		return nil, fmt.Errorf("error tuning device: %s", err)
+	}
+
+	return session, nil
+}
@@ -0,0 +1,249 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+// Backpressure sets what a Stream does with new samples while its buffer is full
+type Backpressure int
+
+const (
+	// Block waits for the consumer, holding the IQ stream of the client and every other stream
+	Block Backpressure = iota
+	// DropNewest discards the arriving blocks until the consumer catches up
+	DropNewest
+	// DropOldest discards the oldest buffered block to make room for the arriving one
+	DropOldest
+)
+
+const defaultStreamBuffer = 16
+
+// StreamOption sets an option of a Stream
+type StreamOption func(*Stream)
+
+// BufferSize sets how many sample blocks a Stream buffers for its consumer
+func BufferSize(blocks int) StreamOption {
+	return func(s *Stream) {
+		s.buffer = blocks
+	}
+}
+
+// WithBackpressure sets what a Stream does when its consumer falls behind. Block is the default.
+func WithBackpressure(policy Backpressure) StreamOption {
+	return func(s *Stream) {
+		s.policy = policy
+	}
+}
+
+// Stream delivers the IQ samples of the first channel of a RadioClient.
+// The sample blocks are shared between the streams and the Callback of the client and must not be modified.
+type Stream struct {
+	client *RadioClient
+
+	buffer  int
+	policy  Backpressure
+	samples chan []complex64
+	dropped uint64
+
+	done      chan struct{}
+	closeOnce sync.Once
+	err       error
+}
+
+// OpenStream starts streaming and returns a Stream of the IQ samples, closed when ctx is done.
+// Closing a Stream leaves the client streaming, Stop ends it.
+func (f *RadioClient) OpenStream(ctx context.Context, opts ...StreamOption) (*Stream, error) {
+	if _, session := f.connection(); session == nil {
+		return nil, fmt.Errorf("no device provisioned")
+	}
+
+	s := &Stream{
+		client: f,
+		buffer: defaultStreamBuffer,
+		policy: Block,
+		done:   make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.buffer < 0 {
+		return nil, fmt.Errorf("invalid buffer size %d", s.buffer)
+	}
+	s.samples = make(chan []complex64, s.buffer)
+
+	f.streamsLock.Lock()
+	if f.streams == nil {
+		f.streams = map[*Stream]struct{}{}
+	}
+	f.streams[s] = struct{}{}
+	f.streamsLock.Unlock()
+
+	f.streamLock.Lock()
+	if !f.streaming || !f.iqChannelEnabled {
+		log.Debug("Starting streaming")
+		f.streaming = true
+		f.iqChannelEnabled = true
+		f.startIQLoop()
+	}
+	f.streamLock.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.close(ctx.Err())
+		case <-s.done:
+		}
+	}()
+
+	return s, nil
+}
+
+// Samples returns the channel of sample blocks, closed with the stream
+func (s *Stream) Samples() <-chan []complex64 {
+	return s.samples
+}
+
+// Dropped returns the number of sample blocks discarded by the backpressure policy
+func (s *Stream) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Err returns why the stream was closed: nil after Close, the context error or the failure of the client stream
+func (s *Stream) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Close stops delivering samples to the stream and closes its channel
+func (s *Stream) Close() error {
+	s.close(nil)
+	return nil
+}
+
+func (s *Stream) close(err error) {
+	s.closeOnce.Do(func() {
+		s.err = err
+		// done unblocks a push in progress, so the client can release the stream
+		close(s.done)
+
+		f := s.client
+		f.streamsLock.Lock()
+		delete(f.streams, s)
+		f.streamsLock.Unlock()
+
+		close(s.samples)
+	})
+}
+
+// push hands samples to the consumer following the backpressure policy.
+// The client streams lock must be held for reading, so the stream isn't closed meanwhile.
+func (s *Stream) push(samples []complex64) {
+	switch s.policy {
+	case DropNewest:
+		select {
+		case s.samples <- samples:
+		case <-s.done:
+		default:
+			atomic.AddUint64(&s.dropped, 1)
+		}
+	case DropOldest:
+		for {
+			select {
+			case s.samples <- samples:
+				return
+			case <-s.done:
+				return
+			default:
+			}
+
+			select {
+			case <-s.samples:
+				atomic.AddUint64(&s.dropped, 1)
+			default:
+			}
+		}
+	default:
+		select {
+		case s.samples <- samples:
+		case <-s.done:
+		}
+	}
+}
+
+// pushStreams hands samples to every open stream of the client
+func (f *RadioClient) pushStreams(samples []complex64) {
+	f.streamsLock.RLock()
+	defer f.streamsLock.RUnlock()
+
+	for s := range f.streams {
+		s.push(samples)
+	}
+}
+
+// hasStreams returns whether the client has open streams
+func (f *RadioClient) hasStreams() bool {
+	f.streamsLock.RLock()
+	defer f.streamsLock.RUnlock()
+	return len(f.streams) > 0
+}
+
+// closeStreams closes every open stream of the client with err
+func (f *RadioClient) closeStreams(err error) {
+	f.streamsLock.RLock()
+	streams := make([]*Stream, 0, len(f.streams))
+	for s := range f.streams {
+		streams = append(streams, s)
+	}
+	f.streamsLock.RUnlock()
+
+	for _, s := range streams {
+		s.close(err)
+	}
+}
+
+// Reader returns a reader of the stream samples, interleaved in format.
+// It returns io.EOF once the stream is closed with Close, or the error that closed it.
+func (s *Stream) Reader(format protocol.SampleFormat) io.Reader {
+	return &streamReader{
+		stream: s,
+		format: format,
+	}
+}
+
+type streamReader struct {
+	stream  *Stream
+	format  protocol.SampleFormat
+	buffer  []byte
+	pending []byte
+}
+
+func (r *streamReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		samples, ok := <-r.stream.samples
+		if !ok {
+			if err := r.stream.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+
+		r.buffer = r.format.Encode(r.buffer, samples)
+		r.pending = r.buffer
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
@@ -2,74 +2,143 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
-	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/luigifreitas/radioserver/protocol"
 	"github.com/quan-to/slog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding/gzip"
+	"gopkg.in/alecthomas/kingpin.v2"
 )
 
-var log = slog.Scope("RadioClient")
+// The log goes to stderr, leaving stdout to the command output
+var log = slog.Scope("RadioClient").WithCustomWriter(os.Stderr)
 
 var empty = &protocol.Empty{}
 
-func main() {
-	flag.Parse()
-	var opts []grpc.DialOption
-	opts = append(opts, grpc.WithInsecure())
-	conn, err := grpc.Dial("localhost:4050", opts...)
+var (
+	app = kingpin.New("client", "Command line client of a RadioServer")
+
+	address       = app.Flag("address", "Address of the server").Short('a').Default("localhost:4050").Envar("RADIOSERVER_ADDRESS").String()
+	useTLS        = app.Flag("tls", "Connect with TLS").Bool()
+	caCert        = app.Flag("ca-cert", "PEM file with the certificate authorities trusted for TLS, the system ones when empty").String()
+	tlsServerName = app.Flag("tls-server-name", "Name checked against the server certificate, the host of the address when empty").String()
+	tlsSkipVerify = app.Flag("tls-skip-verify", "Accept any server certificate").Bool()
+	token         = app.Flag("token", "Token sent as a bearer authorization with every call").Envar("RADIOSERVER_TOKEN").String()
+	callTimeout   = app.Flag("timeout", "Timeout of the calls, streams excepted").Default("10s").Duration()
+	verbose       = app.Flag("verbose", "Log the debug messages").Short('v').Bool()
+)
 
-	if err != nil {
-		log.Fatal(err)
-	}
+// commands maps the full command names to their handlers
+var commands = map[string]func(context.Context, protocol.RadioServerClient) error{}
+
+func main() {
+	command := kingpin.MustParse(app.Parse(os.Args[1:]))
+	slog.SetDebug(*verbose)
 
+	conn, err := dial()
+	app.FatalIfError(err, "")
 	defer conn.Close()
-	client := protocol.NewRadioServerClient(conn)
 
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	// Get Server Meta
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-c
+		log.Info("Interrupted, stopping")
+		cancel()
+	}()
 
-	server, err := client.ServerInfo(ctx, empty)
-	if err != nil {
-		log.Fatal(err)
+	err = commands[command](ctx, protocol.NewRadioServerClient(conn))
+	app.FatalIfError(err, "")
+}
+
+// dial connects to the server with the transport and authorization set by the flags
+func dial() (*grpc.ClientConn, error) {
+	opts := []grpc.DialOption{
+		grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)),
 	}
 
-	serverInfo, _ := json.MarshalIndent(server, "", "   ")
-	log.Info("Server Info: %s", serverInfo)
+	if *useTLS {
+		config := &tls.Config{
+			ServerName:         *tlsServerName,
+			InsecureSkipVerify: *tlsSkipVerify,
+		}
+
+		if *caCert != "" {
+			pem, err := ioutil.ReadFile(*caCert)
+			if err != nil {
+				return nil, fmt.Errorf("error reading %s: %s", *caCert, err)
+			}
+
+			config.RootCAs = x509.NewCertPool()
+			if !config.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no certificate found in %s", *caCert)
+			}
+		}
+
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
 
-	// Get Devices List
-	dls, err := client.List(ctx, empty)
+	if *token != "" {
+		if !*useTLS {
+			log.Warn("Sending the token without TLS")
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken{
+			token:  *token,
+			secure: *useTLS,
+		}))
+	}
+
+	log.Debug("Connecting to %s", *address)
+	conn, err := grpc.Dial(*address, opts...)
 	if err != nil {
-		log.Fatal(err)
+		return nil, fmt.Errorf("error connecting to %s: %s", *address, err)
 	}
 
-	deviceList, _ := json.MarshalIndent(dls, "", "   ")
-	log.Info("Available Devices: %s", deviceList)
+	return conn, nil
+}
 
-	i := protocol.DeviceState{
-		Info: dls.Devices[0],
-		Config: &protocol.DeviceConfig{
-			SampleRate: 3e6,
-		},
-	}
+// bearerToken sends a token as the authorization of every call
+type bearerToken struct {
+	token  string
+	secure bool
+}
 
-	i.Config.RXC = append(i.Config.RXC,
-		&protocol.ChannelConfig{
-			CenterFrequency: 102.9e6,
-			NormalizedGain:  0.85,
-			Antenna:         "LNAW",
-		})
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization": "Bearer " + t.token,
+	}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return t.secure
+}
 
-	deviceProv, _ := json.MarshalIndent(i, "", "   ")
-	log.Info("Provisioning Device: %s", deviceProv)
+// call returns the context of a unary call, bounded by the timeout flag
+func call(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, *callTimeout)
+}
 
-	dinf, err := client.Provision(ctx, &i)
+// printJSON writes v indented to stdout
+func printJSON(v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "   ")
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	deviceInfo, _ := json.MarshalIndent(dinf, "", "   ")
-	log.Info("Device Info: %s", deviceInfo)
+	_, err = fmt.Println(string(data))
+	return err
 }
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/luigifreitas/radioserver/client"
+	"github.com/luigifreitas/radioserver/protocol"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+const defaultSampleRate = 2e6
+
+// deviceFlags are the flags picking and setting up a device
+type deviceFlags struct {
+	serial     *string
+	index      *int
+	sampleRate *optionalValue
+	oversample *uint32
+	channel    *channelFlags
+}
+
+func addDeviceFlags(cmd *kingpin.CmdClause) *deviceFlags {
+	return &deviceFlags{
+		serial:     cmd.Flag("serial", "Serial of the device, the first one of the server when empty").String(),
+		index:      cmd.Flag("device", "Index of the device in the list of the server").Default("-1").Int(),
+		sampleRate: hertzFlag(cmd.Flag("sample-rate", "Device sample rate, 2M by default")),
+		oversample: cmd.Flag("oversample", "Device oversampling ratio").Uint32(),
+		channel:    addChannelFlags(cmd),
+	}
+}
+
+// sessionFlag declares the flag of the session token of a command
+func sessionFlag(cmd *kingpin.CmdClause) *kingpin.FlagClause {
+	return cmd.Flag("session", "Session token printed by provision").Short('s').Envar("RADIOSERVER_SESSION")
+}
+
+var (
+	listCmd  = app.Command("list", "List the devices of the server")
+	listJSON = listCmd.Flag("json", "Print JSON").Bool()
+
+	infoCmd     = app.Command("info", "Show the server information and the configuration of a session")
+	infoSession = sessionFlag(infoCmd).String()
+
+	provisionCmd    = app.Command("provision", "Open a device and print the session token")
+	provisionDevice = addDeviceFlags(provisionCmd)
+
+	tuneCmd     = app.Command("tune", "Change the first channel of a session and show the configuration applied")
+	tuneSession = sessionFlag(tuneCmd).Required().String()
+	tuneChannel = addChannelFlags(tuneCmd)
+
+	destroyCmd     = app.Command("destroy", "Close a session and its device")
+	destroySession = sessionFlag(destroyCmd).Required().String()
+)
+
+func init() {
+	commands[listCmd.FullCommand()] = list
+	commands[infoCmd.FullCommand()] = info
+	commands[provisionCmd.FullCommand()] = provision
+	commands[tuneCmd.FullCommand()] = tune
+	commands[destroyCmd.FullCommand()] = destroy
+}
+
+func list(ctx context.Context, c protocol.RadioServerClient) error {
+	ctx, cancel := call(ctx)
+	defer cancel()
+
+	dls, err := c.List(ctx, empty)
+	if err != nil {
+		return fmt.Errorf("error listing devices: %s", err)
+	}
+
+	if *listJSON {
+		return printJSON(dls.Devices)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "INDEX\tSERIAL\tNAME\tFREQUENCY (Hz)\tMAX SAMPLE RATE\tRX\tTX")
+	for i, d := range dls.Devices {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d - %d\t%d\t%d\t%d\n", i, d.Serial, d.Name, d.MinimumFrequency, d.MaximumFrequency,
+			d.MaximumSampleRate, d.MaximumRXChannels, d.MaximumTXChannels)
+	}
+	return w.Flush()
+}
+
+func info(ctx context.Context, c protocol.RadioServerClient) error {
+	ctx, cancel := call(ctx)
+	defer cancel()
+
+	server, err := c.ServerInfo(ctx, empty)
+	if err != nil {
+		return fmt.Errorf("error fetching server info: %s", err)
+	}
+
+	out := struct {
+		Name         string
+		Version      string
+		DeviceConfig *protocol.DeviceConfig     `json:",omitempty"`
+		IQCorrection *protocol.IQCorrectionList `json:",omitempty"`
+	}{
+		Name:    server.Name,
+		Version: server.Version.AsString(),
+	}
+
+	if *infoSession != "" {
+		sid := &protocol.Session{Token: *infoSession}
+
+		if out.DeviceConfig, err = c.GetDeviceConfig(ctx, sid); err != nil {
+			return fmt.Errorf("error fetching the session configuration: %s", err)
+		}
+		if out.IQCorrection, err = c.GetIQCorrection(ctx, sid); err != nil {
+			return fmt.Errorf("error fetching the session IQ correction: %s", err)
+		}
+	}
+
+	return printJSON(out)
+}
+
+func provision(ctx context.Context, c protocol.RadioServerClient) error {
+	sid, err := openDevice(ctx, c, provisionDevice)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(sid.Token)
+	return nil
+}
+
+// openDevice provisions the device picked by f, returning its session
+func openDevice(ctx context.Context, c protocol.RadioServerClient, f *deviceFlags) (*protocol.Session, error) {
+	if !f.channel.frequency.set {
+		return nil, fmt.Errorf("a frequency is needed to open a device")
+	}
+
+	ctx, cancel := call(ctx)
+	defer cancel()
+
+	dls, err := c.List(ctx, empty)
+	if err != nil {
+		return nil, fmt.Errorf("error listing devices: %s", err)
+	}
+
+	device, err := pickDevice(dls.Devices, f)
+	if err != nil {
+		return nil, err
+	}
+
+	sampleRate := defaultSampleRate
+	if f.sampleRate.set {
+		sampleRate = f.sampleRate.value
+	}
+
+	config := client.NewDeviceConfig(
+		client.SampleRate(float32(sampleRate)),
+		client.Oversample(*f.oversample),
+	)
+	f.channel.apply(config.RXC[0])
+
+	log.Debug("Provisioning %s %s", device.Name, device.Serial)
+	sid, err := c.Provision(ctx, &protocol.DeviceState{
+		Info:   device,
+		Config: config,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error provisioning device: %s", err)
+	}
+
+	return sid, nil
+}
+
+// pickDevice returns the device of devices selected by the serial or the index of f
+func pickDevice(devices []*protocol.DeviceInfo, f *deviceFlags) (*protocol.DeviceInfo, error) {
+	if *f.index >= 0 {
+		if *f.index >= len(devices) {
+			return nil, fmt.Errorf("the server has %d devices", len(devices))
+		}
+		return devices[*f.index], nil
+	}
+
+	for _, d := range devices {
+		if *f.serial == "" || client.BySerial(*f.serial)(d) {
+			return d, nil
+		}
+	}
+
+	if *f.serial != "" {
+		return nil, fmt.Errorf("no device with serial %s", *f.serial)
+	}
+	return nil, fmt.Errorf("the server has no devices")
+}
+
+// useSession returns the session of token, or opens one with the device flags when token is empty.
+// The session channel is tuned to the given channel flags. owned reports whether the session was opened here.
+func useSession(ctx context.Context, c protocol.RadioServerClient, token string, f *deviceFlags) (sid *protocol.Session, owned bool, err error) {
+	if token == "" {
+		sid, err = openDevice(ctx, c, f)
+		return sid, err == nil, err
+	}
+
+	sid = &protocol.Session{Token: token}
+	if f.channel.any() {
+		if _, err := retune(ctx, c, sid, f.channel); err != nil {
+			return nil, false, err
+		}
+	}
+
+	return sid, false, nil
+}
+
+// closeSession destroys a session opened by the command
+func closeSession(c protocol.RadioServerClient, sid *protocol.Session) {
+	ctx, cancel := call(context.Background())
+	defer cancel()
+
+	// Ending an IQ stream already destroys the session, so a failure is expected
+	if _, err := c.Destroy(ctx, sid); err != nil {
+		log.Debug("Destroying session: %s", err)
+	}
+}
+
+// retune applies the channel flags to the first channel of a session and returns the configuration applied
+func retune(ctx context.Context, c protocol.RadioServerClient, sid *protocol.Session, f *channelFlags) (*protocol.DeviceConfig, error) {
+	ctx, cancel := call(ctx)
+	defer cancel()
+
+	config, err := c.GetDeviceConfig(ctx, sid)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching the session configuration: %s", err)
+	}
+	if len(config.RXC) == 0 {
+		return nil, fmt.Errorf("the device has no receive channel")
+	}
+
+	f.apply(config.RXC[0])
+
+	applied, err := c.Tune(ctx, &protocol.DeviceTune{
+		Session: sid,
+		Config:  config,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error tuning device: %s", err)
+	}
+
+	return applied, nil
+}
+
+func tune(ctx context.Context, c protocol.RadioServerClient) error {
+	if !tuneChannel.any() {
+		return fmt.Errorf("nothing to tune")
+	}
+
+	applied, err := retune(ctx, c, &protocol.Session{Token: *tuneSession}, tuneChannel)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(applied)
+}
+
+func destroy(ctx context.Context, c protocol.RadioServerClient) error {
+	ctx, cancel := call(ctx)
+	defer cancel()
+
+	if _, err := c.Destroy(ctx, &protocol.Session{Token: *destroySession}); err != nil {
+		return fmt.Errorf("error destroying session: %s", err)
+	}
+	return nil
+}
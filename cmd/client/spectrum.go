@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+// spectrumSpan is the part of the sample rate measured when no range is given
+const spectrumSpan = 0.8
+
+var (
+	spectrumCmd         = app.Command("spectrum", "Print the power spectrum of a frequency range, sweeping the session channel across it")
+	spectrumSession     = sessionFlag(spectrumCmd).String()
+	spectrumDevice      = addDeviceFlags(spectrumCmd)
+	spectrumStart       = hertzFlag(spectrumCmd.Flag("start", "Start of the range, the band around the channel frequency by default"))
+	spectrumStop        = hertzFlag(spectrumCmd.Flag("stop", "Stop of the range"))
+	spectrumBinWidth    = hertzFlag(spectrumCmd.Flag("bin-width", "Largest width of a bin"))
+	spectrumAverages    = spectrumCmd.Flag("averages", "Spectra averaged on every hop").Uint32()
+	spectrumOverlap     = spectrumCmd.Flag("overlap", "Overlap of the hops, from 0 to 1").Float32()
+	spectrumCalibration = spectrumCmd.Flag("calibration-offset", "Offset added to the levels, in dB").Float32()
+	spectrumSweeps      = spectrumCmd.Flag("sweeps", "Number of sweeps, 0 to sweep until interrupted").Default("1").Uint32()
+	spectrumJSON        = spectrumCmd.Flag("json", "Print a JSON frame per sweep instead of frequency,power lines").Bool()
+)
+
+func init() {
+	commands[spectrumCmd.FullCommand()] = spectrum
+}
+
+func spectrum(ctx context.Context, c protocol.RadioServerClient) error {
+	sid, owned, err := useSession(ctx, c, *spectrumSession, spectrumDevice)
+	if err != nil {
+		return err
+	}
+	if owned {
+		defer closeSession(c, sid)
+	}
+
+	start, stop := spectrumStart.value, spectrumStop.value
+	if !spectrumStart.set || !spectrumStop.set {
+		if start, stop, err = tunedBand(ctx, c, sid); err != nil {
+			return err
+		}
+	}
+
+	sweep, err := c.Sweep(ctx, &protocol.SweepRequest{
+		Session:           sid,
+		StartFrequency:    start,
+		StopFrequency:     stop,
+		BinWidth:          spectrumBinWidth.value,
+		Averages:          *spectrumAverages,
+		Overlap:           *spectrumOverlap,
+		CalibrationOffset: *spectrumCalibration,
+		Sweeps:            *spectrumSweeps,
+	})
+	if err != nil {
+		return fmt.Errorf("error starting the sweep: %s", err)
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+
+	for {
+		frame, err := sweep.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("sweep failed: %s", err)
+		}
+
+		if *spectrumJSON {
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			if err := printJSON(frame); err != nil {
+				return err
+			}
+			continue
+		}
+
+		fmt.Fprintf(w, "# sweep %d at %s, %d hops in %s\n", frame.Sweep,
+			time.Unix(0, int64(frame.Timestamp)).Format(time.RFC3339Nano), frame.Hops, time.Duration(frame.Duration))
+		for i, p := range frame.Power {
+			fmt.Fprintf(w, "%.0f,%.2f\n", frame.StartFrequency+float64(i)*frame.BinWidth, p)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+// tunedBand returns the range received by the session channel at its current frequency
+func tunedBand(ctx context.Context, c protocol.RadioServerClient, sid *protocol.Session) (float64, float64, error) {
+	ctx, cancel := call(ctx)
+	defer cancel()
+
+	config, err := c.GetDeviceConfig(ctx, sid)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error fetching the session configuration: %s", err)
+	}
+	if len(config.RXC) == 0 {
+		return 0, 0, fmt.Errorf("the device has no receive channel")
+	}
+
+	center := float64(config.RXC[0].CenterFrequency)
+	half := float64(config.SampleRate) * spectrumSpan / 2
+	return center - half, center + half, nil
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/luigifreitas/radioserver/protocol"
+	"github.com/luigifreitas/radioserver/sigmf"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// captureFlags are the flags of the commands receiving the IQ samples of a session
+type captureFlags struct {
+	session  *string
+	device   *deviceFlags
+	rate     *optionalValue
+	samples  *uint64
+	duration *time.Duration
+	format   *string
+}
+
+func addCaptureFlags(cmd *kingpin.CmdClause) *captureFlags {
+	return &captureFlags{
+		session:  sessionFlag(cmd).String(),
+		device:   addDeviceFlags(cmd),
+		rate:     hertzFlag(cmd.Flag("rate", "Sample rate the server resamples the channel to")),
+		samples:  cmd.Flag("samples", "Stop after that many samples").Short('n').Uint64(),
+		duration: cmd.Flag("duration", "Stop after that time, like 10s").Short('d').Duration(),
+		format:   sampleFormatFlag(cmd),
+	}
+}
+
+var (
+	streamCmd     = app.Command("stream", "Write the IQ samples of a session to a file or stdout. The session ends with the stream.")
+	streamCapture = addCaptureFlags(streamCmd)
+	streamOutput  = streamCmd.Flag("output", "File to write, - for stdout").Short('o').Default("-").String()
+
+	recordCmd         = app.Command("record", "Record the IQ samples of a session as SigMF. The session ends with the recording.")
+	recordCapture     = addCaptureFlags(recordCmd)
+	recordPath        = recordCmd.Arg("path", "Base path of the .sigmf-data and .sigmf-meta files").Required().String()
+	recordDescription = recordCmd.Flag("description", "Description stored in the metadata").String()
+)
+
+func init() {
+	commands[streamCmd.FullCommand()] = stream
+	commands[recordCmd.FullCommand()] = record
+}
+
+func stream(ctx context.Context, c protocol.RadioServerClient) error {
+	var out io.Writer = os.Stdout
+	if *streamOutput != "-" {
+		f, err := os.Create(*streamOutput)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	w := bufio.NewWriter(out)
+	format := sampleFormat(*streamCapture.format)
+
+	var buff []byte
+	received, err := captureSession(ctx, c, streamCapture, nil, func(_ *protocol.IQData, samples []complex64) error {
+		buff = format.Encode(buff, samples)
+		_, err := w.Write(buff)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("Received %d samples", received)
+	return w.Flush()
+}
+
+func record(ctx context.Context, c protocol.RadioServerClient) error {
+	var w *sigmf.Writer
+	defer func() {
+		if w != nil {
+			if err := w.Close(); err != nil {
+				log.Error("Error closing the recording: %s", err)
+			}
+		}
+	}()
+
+	var config *protocol.DeviceConfig
+	var hardware string
+
+	received, err := captureSession(ctx, c, recordCapture, func(sid *protocol.Session) error {
+		ctx, cancel := call(ctx)
+		defer cancel()
+
+		var err error
+		config, err = c.GetDeviceConfig(ctx, sid)
+		if err != nil {
+			return fmt.Errorf("error fetching the session configuration: %s", err)
+		}
+		if len(config.RXC) == 0 {
+			return fmt.Errorf("the device has no receive channel")
+		}
+
+		server, err := c.ServerInfo(ctx, empty)
+		if err != nil {
+			return fmt.Errorf("error fetching server info: %s", err)
+		}
+		hardware = fmt.Sprintf("%s at %s", server.Name, *address)
+		return nil
+	}, func(data *protocol.IQData, samples []complex64) error {
+		if w == nil {
+			// The rate of the chain output is only known with the first samples
+			var err error
+			w, err = sigmf.Create(*recordPath, sampleFormat(*recordCapture.format), sigmf.Global{
+				SampleRate:  float64(data.SampleRate),
+				Description: *recordDescription,
+				Recorder:    "radioserver client",
+				Hardware:    hardware,
+			})
+			if err != nil {
+				return err
+			}
+
+			err = w.AddCapture(sigmf.Capture{
+				Frequency: float64(config.RXC[0].CenterFrequency),
+				DateTime:  sigmf.FormatDateTime(time.Unix(0, int64(data.Timestamp))),
+				Gain:      config.RXC[0].NormalizedGain,
+				Antenna:   config.RXC[0].Antenna,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return w.Write(samples)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("Recorded %d samples to %s", received, *recordPath)
+	return nil
+}
+
+// captureSession opens the session set by f, calls prepare with it and streams its IQ samples to handle
+// until the limits of f are reached or ctx is done
+func captureSession(ctx context.Context, c protocol.RadioServerClient, f *captureFlags, prepare func(*protocol.Session) error, handle func(*protocol.IQData, []complex64) error) (uint64, error) {
+	sid, owned, err := useSession(ctx, c, *f.session, f.device)
+	if err != nil {
+		return 0, err
+	}
+	if owned {
+		defer closeSession(c, sid)
+	}
+
+	if f.rate.set {
+		if err := resample(ctx, c, sid, f.rate.value); err != nil {
+			return 0, err
+		}
+	}
+
+	if prepare != nil {
+		if err := prepare(sid); err != nil {
+			return 0, err
+		}
+	}
+
+	if *f.duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *f.duration)
+		defer cancel()
+	}
+
+	return receiveIQ(ctx, c, sid, *f.samples, handle)
+}
+
+// resample sets the chain of a session to deliver the channel at rate
+func resample(ctx context.Context, c protocol.RadioServerClient, sid *protocol.Session, rate float64) error {
+	ctx, cancel := call(ctx)
+	defer cancel()
+
+	chain, err := c.ConfigureChain(ctx, &protocol.ProcessingChain{
+		Session: sid,
+		Blocks: []*protocol.BlockConfig{
+			{
+				Type: protocol.BlockType_ResamplerBlock,
+				Rate: rate,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting the sample rate: %s", err)
+	}
+
+	log.Info("Streaming at %v samples per second", chain.SinkRates[0])
+	return nil
+}
+
+// receiveIQ hands the samples of the first chain output of a session to handle, up to limit samples
+// when not zero. It returns the number of samples handled once the stream ends or ctx is done.
+func receiveIQ(ctx context.Context, c protocol.RadioServerClient, sid *protocol.Session, limit uint64, handle func(*protocol.IQData, []complex64) error) (uint64, error) {
+	// Cancelling the call ends the stream when the limit is reached
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	iq, err := c.RXIQ(ctx, sid)
+	if err != nil {
+		return 0, fmt.Errorf("error starting the IQ stream: %s", err)
+	}
+
+	var received, next uint64
+	for limit == 0 || received < limit {
+		data, err := iq.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return received, nil
+		}
+		if err != nil {
+			return received, fmt.Errorf("IQ stream failed: %s", err)
+		}
+
+		if data.Status == protocol.StatusType_Error {
+			return received, fmt.Errorf("server error: %s", data.Error)
+		}
+		if data.Squelch != nil || data.Sink != 0 || data.Kind != protocol.SampleKind_IQSamples {
+			continue
+		}
+
+		samples := data.GetComplexSamples()
+		if received > 0 && data.SampleIndex != next+data.Squelched {
+			log.Warn("Lost %d samples at %d", data.SampleIndex-next-data.Squelched, data.SampleIndex)
+		}
+		next = data.SampleIndex + uint64(len(samples))
+
+		if limit > 0 && received+uint64(len(samples)) > limit {
+			samples = samples[:limit-received]
+		}
+
+		if err := handle(data, samples); err != nil {
+			return received, err
+		}
+		received += uint64(len(samples))
+	}
+
+	return received, nil
+}
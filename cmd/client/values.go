@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/luigifreitas/radioserver/protocol"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// hertzSuffixes are the multipliers accepted after a frequency
+var hertzSuffixes = map[string]float64{
+	"k": 1e3,
+	"M": 1e6,
+	"G": 1e9,
+}
+
+// parseHertz parses a frequency in Hertz, like 102.9M, 2400k or 1e6
+func parseHertz(s string) (float64, error) {
+	s = strings.TrimSuffix(strings.TrimSpace(s), "Hz")
+
+	multiplier := 1.0
+	if len(s) > 0 {
+		if m, ok := hertzSuffixes[s[len(s)-1:]]; ok {
+			multiplier = m
+			s = s[:len(s)-1]
+		}
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid frequency %q", s)
+	}
+
+	return v * multiplier, nil
+}
+
+// optionalValue is a flag value remembering whether it was given
+type optionalValue struct {
+	set   bool
+	text  string
+	value float64
+	parse func(string) (float64, error)
+}
+
+func (o *optionalValue) Set(s string) error {
+	if o.parse != nil {
+		v, err := o.parse(s)
+		if err != nil {
+			return err
+		}
+		o.value = v
+	}
+
+	o.text = s
+	o.set = true
+	return nil
+}
+
+func (o *optionalValue) String() string {
+	return o.text
+}
+
+// hertzFlag declares an optional frequency flag
+func hertzFlag(f *kingpin.FlagClause) *optionalValue {
+	v := &optionalValue{parse: parseHertz}
+	f.PlaceHolder("HZ").SetValue(v)
+	return v
+}
+
+// numberFlag declares an optional number flag
+func numberFlag(f *kingpin.FlagClause) *optionalValue {
+	v := &optionalValue{parse: func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	}}
+	f.SetValue(v)
+	return v
+}
+
+// stringFlag declares an optional string flag
+func stringFlag(f *kingpin.FlagClause) *optionalValue {
+	v := &optionalValue{}
+	f.SetValue(v)
+	return v
+}
+
+// channelFlags are the flags setting the first receive channel of a device
+type channelFlags struct {
+	frequency        *optionalValue
+	gain             *optionalValue
+	antenna          *optionalValue
+	analogBandwidth  *optionalValue
+	digitalBandwidth *optionalValue
+	digitalGain      *optionalValue
+}
+
+func addChannelFlags(cmd *kingpin.CmdClause) *channelFlags {
+	return &channelFlags{
+		frequency:        hertzFlag(cmd.Flag("frequency", "Center frequency, like 102.9M").Short('f')),
+		gain:             numberFlag(cmd.Flag("gain", "Normalized gain, from 0 to 1").Short('g')),
+		antenna:          stringFlag(cmd.Flag("antenna", "Antenna name")),
+		analogBandwidth:  hertzFlag(cmd.Flag("analog-bandwidth", "Analog filter bandwidth")),
+		digitalBandwidth: hertzFlag(cmd.Flag("digital-bandwidth", "Digital filter bandwidth, 0 disables it")),
+		digitalGain:      numberFlag(cmd.Flag("digital-gain", "Gain applied to the channel outputs, in dB")),
+	}
+}
+
+// apply sets the given flags on c
+func (f *channelFlags) apply(c *protocol.ChannelConfig) {
+	if f.frequency.set {
+		c.CenterFrequency = float32(f.frequency.value)
+	}
+	if f.gain.set {
+		c.NormalizedGain = float32(f.gain.value)
+	}
+	if f.antenna.set {
+		c.Antenna = f.antenna.text
+	}
+	if f.analogBandwidth.set {
+		c.AnalogFilterBandwidth = float32(f.analogBandwidth.value)
+	}
+	if f.digitalBandwidth.set {
+		c.DigitalFilterBandwidth = float32(f.digitalBandwidth.value)
+	}
+	if f.digitalGain.set {
+		c.DigitalGain = float32(f.digitalGain.value)
+	}
+}
+
+// any returns whether a flag was given
+func (f *channelFlags) any() bool {
+	return f.frequency.set || f.gain.set || f.antenna.set || f.analogBandwidth.set || f.digitalBandwidth.set || f.digitalGain.set
+}
+
+// sampleFormatFlag declares a sample format flag, cf32 by default
+func sampleFormatFlag(cmd *kingpin.CmdClause) *string {
+	return cmd.Flag("format", "Sample format: cf32, cs16, cs8 or cu8").Default("cf32").Enum("cf32", "cs16", "cs8", "cu8")
+}
+
+// sampleFormat returns the protocol format named name
+func sampleFormat(name string) protocol.SampleFormat {
+	return protocol.SampleFormat(protocol.SampleFormat_value[strings.ToUpper(name)])
+}
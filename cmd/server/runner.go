@@ -1,25 +1,247 @@
 package main
 
 import (
-	"flag"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/signal"
 	"runtime/debug"
 	"runtime/pprof"
 	"syscall"
+	"text/tabwriter"
+	"time"
 
+	"github.com/logrusorgru/aurora"
 	"github.com/luigifreitas/radioserver"
+	"github.com/luigifreitas/radioserver/DSP"
 	"github.com/luigifreitas/radioserver/server"
 	"github.com/quan-to/slog"
 	"github.com/racerxdl/segdsp/dsp"
+	"gopkg.in/alecthomas/kingpin.v2"
 )
 
 var log = slog.Scope("RadioServer")
-var cpuprofile = flag.String("cpuprofile", "", "write cpu profile to file")
+
+const shutdownTimeout = time.Second * 10
+
+var (
+	app = kingpin.New("radioserver", "SegDSP based SDR server")
+
+	configPath     = app.Flag("config", "JSON configuration file, the flags override its settings").Short('c').String()
+	listen         = app.Flag("listen", "Address to listen on, repeat it for several. Defaults to :4050").Short('l').Strings()
+	httpListen     = app.Flag("http", "Address to serve the WebSocket gateway and the REST API on, repeat it for several. Disabled by default").Strings()
+	httpOrigins    = app.Flag("http-origin", "Origin of the pages allowed to open a WebSocket, like https://example.com, repeat it for several. * allows any. Only the server own by default").Strings()
+	serverName     = app.Flag("name", "Server name. Defaults to helium").Short('n').String()
+	logLevel       = app.Flag("log-level", "Lowest level logged: debug, info, warn or error. Defaults to debug").Enum("debug", "info", "warn", "error")
+	logFormat      = app.Flag("log-format", "Log format: color or plain. Defaults to color").Enum("color", "plain")
+	drivers        = app.Flag("driver", "Frontend driver to enable, repeat it for several. Every driver by default").Strings()
+	devices        = app.Flag("device", "Serial of a device to serve, repeat it for several. Every device by default").Strings()
+	recordingsPath = app.Flag("recordings", "Folder of the recordings").String()
+	statePath      = app.Flag("state", "Folder of the persistent state").String()
+	listDevices    = app.Flag("list-devices", "List the devices of the enabled drivers and exit").Bool()
+	cpuprofile     = app.Flag("cpuprofile", "Write a CPU profile to file").String()
+)
+
+// config holds the settings of the server, read from the configuration file and overridden by the flags
+type config struct {
+	Listen []string
+	// HTTPListen are the addresses of the WebSocket gateway and the REST API, disabled when empty
+	HTTPListen []string
+	// HTTPOrigins are the origins of the pages allowed to open a WebSocket besides the server own, * for any
+	HTTPOrigins []string
+	Name        string
+	LogLevel    string
+	LogFormat   string
+
+	Drivers []string
+	Devices []string
+
+	RecordingsPath string
+	StatePath      string
+
+	// MaxRecordingsSize in bytes and MaxRecordingsAge, like 72h, bound the recordings storage
+	MaxRecordingsSize int64
+	MaxRecordingsAge  string
+
+	// OverflowPolicy is drop-newest, drop-oldest or block, which only holds back the chain for slow stream senders
+	OverflowPolicy string
+}
+
+var defaultConfig = config{
+	Listen:    []string{":4050"},
+	Name:      "helium",
+	LogLevel:  "debug",
+	LogFormat: "color",
+}
+
+// loadConfig returns the default settings, overridden by the configuration file and then by the flags
+func loadConfig() (config, error) {
+	c := defaultConfig
+
+	if *configPath != "" {
+		data, err := ioutil.ReadFile(*configPath)
+		if err != nil {
+			return c, err
+		}
+		if err := json.Unmarshal(data, &c); err != nil {
+			return c, fmt.Errorf("error parsing %s: %s", *configPath, err)
+		}
+	}
+
+	if len(*listen) > 0 {
+		c.Listen = *listen
+	}
+	if len(*httpListen) > 0 {
+		c.HTTPListen = *httpListen
+	}
+	if len(*httpOrigins) > 0 {
+		c.HTTPOrigins = *httpOrigins
+	}
+	if *serverName != "" {
+		c.Name = *serverName
+	}
+	if *logLevel != "" {
+		c.LogLevel = *logLevel
+	}
+	if *logFormat != "" {
+		c.LogFormat = *logFormat
+	}
+	if len(*drivers) > 0 {
+		c.Drivers = *drivers
+	}
+	if len(*devices) > 0 {
+		c.Devices = *devices
+	}
+	if *recordingsPath != "" {
+		c.RecordingsPath = *recordingsPath
+	}
+	if *statePath != "" {
+		c.StatePath = *statePath
+	}
+
+	return c, nil
+}
+
+// logLevels are the log levels from the most verbose
+var logLevels = []string{"debug", "info", "warn", "error"}
+
+// setupLog applies the log level and format of c
+func setupLog(c config) error {
+	level := -1
+	for i, l := range logLevels {
+		if l == c.LogLevel {
+			level = i
+		}
+	}
+	if level < 0 {
+		return fmt.Errorf("invalid log level %s", c.LogLevel)
+	}
+
+	slog.SetDebug(level <= 0)
+	slog.SetInfo(level <= 1)
+	slog.SetWarning(level <= 2)
+	slog.SetError(level <= 3)
+
+	switch c.LogFormat {
+	case "color":
+	case "plain":
+		slog.ColorInfo = plain
+		slog.ColorWarn = plain
+		slog.ColorError = plain
+		slog.ColorDebug = plain
+	default:
+		return fmt.Errorf("invalid log format %s", c.LogFormat)
+	}
+
+	return nil
+}
+
+// plain formats a log field without colors, removing the ones already applied to it
+func plain(arg interface{}) aurora.Value {
+	if v, ok := arg.(aurora.Value); ok {
+		arg = v.Value()
+	}
+	return aurora.NewAurora(false).Bold(arg)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// configure applies the settings of c to srv
+func configure(srv *server.RadioServer, c config) error {
+	if err := srv.SetDrivers(c.Drivers); err != nil {
+		return err
+	}
+	srv.SetDeviceAllowlist(c.Devices)
+	srv.SetHTTPOrigins(c.HTTPOrigins)
+
+	if c.RecordingsPath != "" {
+		srv.SetRecordingsPath(c.RecordingsPath)
+	}
+	if c.StatePath != "" {
+		srv.SetStatePath(c.StatePath)
+	}
+
+	var maxAge time.Duration
+	if c.MaxRecordingsAge != "" {
+		var err error
+		if maxAge, err = time.ParseDuration(c.MaxRecordingsAge); err != nil {
+			return fmt.Errorf("invalid recordings age %s: %s", c.MaxRecordingsAge, err)
+		}
+	}
+	srv.SetRecordingsRetention(c.MaxRecordingsSize, maxAge)
+
+	if c.OverflowPolicy != "" {
+		found := false
+		for policy, name := range DSP.OverflowPolicyNames {
+			if name == c.OverflowPolicy {
+				srv.SetOverflowPolicy(policy)
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("invalid overflow policy %s", c.OverflowPolicy)
+		}
+	}
+
+	return nil
+}
+
+// printDevices writes the devices found by each enabled driver of srv to stdout
+func printDevices(srv *server.RadioServer, c config) error {
+	found := srv.FindDevices()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "DRIVER\tNAME\tSERIAL\tFREQUENCY (Hz)\tMAX SAMPLE RATE\tRX\tTX\tSERVED")
+	for _, driver := range server.Drivers() {
+		dl := found[driver]
+		if dl == nil {
+			continue
+		}
+
+		for _, d := range dl.Devices {
+			served := len(c.Devices) == 0 || contains(c.Devices, d.Serial)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d - %d\t%d\t%d\t%d\t%v\n", driver, d.Name, d.Serial, d.MinimumFrequency,
+				d.MaximumFrequency, d.MaximumSampleRate, d.MaximumRXChannels, d.MaximumTXChannels, served)
+		}
+	}
+	return w.Flush()
+}
 
 func main() {
-	flag.Parse()
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	c, err := loadConfig()
+	app.FatalIfError(err, "")
+	app.FatalIfError(setupLog(c), "")
+
 	if *cpuprofile != "" {
 		f, err := os.Create(*cpuprofile)
 		if err != nil {
@@ -37,29 +259,42 @@ func main() {
 		}
 	}()
 
-	serverName := "helium"
+	srv := server.MakeRadioServer(c.Name)
+	app.FatalIfError(configure(srv, c), "")
 
-	log.Info("Server Name: %s", serverName)
+	if *listDevices {
+		app.FatalIfError(printDevices(srv, c), "")
+		return
+	}
+
+	log.Info("Server Name: %s", c.Name)
 	log.Info("Protocol Version: %s", radioserver.ServerVersion.AsString())
 	log.Info("SIMD Mode: %s", dsp.GetSIMDMode())
 
-	srv := server.MakeRadioServer(serverName)
-	err := srv.Listen(":4050")
+	err = srv.Listen(c.Listen...)
 	if err != nil {
 		log.Error("Error listening: %s", err)
+		os.Exit(1)
+	}
+	if len(c.HTTPListen) > 0 {
+		if err := srv.ListenHTTP(c.HTTPListen...); err != nil {
+			log.Error("Error listening: %s", err)
+			srv.Stop()
+			os.Exit(1)
+		}
 	}
 	stop := make(chan bool, 1)
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
 
 	go func() {
-		<-c
+		<-sig
 		log.Info("Got SIGTERM! Closing it")
 		stop <- true
 	}()
 
 	<-stop
 
-	srv.Stop()
+	srv.GracefulStop(shutdownTimeout)
 	log.Info("Done")
 }
@@ -1,6 +1,8 @@
 package frontends
 
 import (
+	"fmt"
+	"math"
 	"strconv"
   "time"
   "github.com/luigifreitas/radioserver/protocol"
@@ -17,13 +19,27 @@ type LimeSDRFrontend struct {
 	info    *protocol.DeviceInfo
 	config  *protocol.DeviceConfig
 	running bool
+	closed  bool
+
+	// sampleRate and oversample are the last requested, the device rounds them
+	sampleRate float32
+	oversample uint32
 }
 
 func CreateLimeSDRFrontend(state *protocol.DeviceState) Frontend {
-	devices := limedrv.GetDevices()
+	var found *limedrv.DeviceInfo
+	for i, d := range limedrv.GetDevices() {
+		if limeSerial(i, d) == state.Info.Serial {
+			found = &d
+			break
+		}
+	}
+	if found == nil {
+		limeLog.Error("No device with serial %s", state.Info.Serial)
+		return nil
+	}
 
-	i, _ := strconv.Atoi(state.Info.Serial)
-	var device = limedrv.Open(devices[i])
+	var device = limedrv.Open(*found)
 
 	var f = &LimeSDRFrontend{
 		device:  device,
@@ -33,14 +49,12 @@ func CreateLimeSDRFrontend(state *protocol.DeviceState) Frontend {
 	}
 
 	f.device.
-		SetCallback(func(samples []complex64, _ int, _ uint64) {
+		SetCallback(func(samples []complex64, _ int, timestamp uint64) {
 			if f.cb != nil {
-				f.cb(samples)
+				f.cb(samples, timestamp)
 			}
 		})
 
-	f.device.SetSampleRate(float64(state.Config.SampleRate), int(state.Config.Oversample))
-
   for i, _ := range state.Config.RXC {
       limeLog.Info("Channel %d: Activating Channel.", i)
       f.device.RXChannels[i].Enable()
@@ -57,12 +71,21 @@ func FindLimeSuiteDevices(dl *protocol.DeviceList) {
 	for i, d := range devices {
 		if d.Module == "FT601" {
 			b := LimeSDRMiniDefault
-			b.Serial = strconv.Itoa(i)
+			b.Serial = limeSerial(i, d)
 			dl.Devices = append(dl.Devices, &b)
 		}
 	}
 }
 
+// limeSerial returns the board serial of a device, which identifies it across replugs. The few boards reporting
+// none are identified by their position in the device list instead, marked with a # not to look like a serial.
+func limeSerial(index int, d limedrv.DeviceInfo) string {
+	if d.Serial != "" {
+		return d.Serial
+	}
+	return "#" + strconv.Itoa(index)
+}
+
 func (f *LimeSDRFrontend) GetDeviceInfo() protocol.DeviceInfo {
 	return *f.info
 }
@@ -71,7 +94,23 @@ func (f *LimeSDRFrontend) GetDeviceConfig() protocol.DeviceConfig {
 	return *f.config
 }
 
+// SetDeviceConfig applies c to the device and returns the configuration applied, with the sample rate and
+// oversampling the device runs at. A zero sample rate keeps the current one.
 func (f *LimeSDRFrontend) SetDeviceConfig(c *protocol.DeviceConfig) protocol.DeviceConfig {
+	applied := *c
+	// Either the rate requested or the one applied keep the device as it is
+	rateChanged := c.SampleRate != f.sampleRate && c.SampleRate != f.config.SampleRate
+	oversampleChanged := c.Oversample != f.oversample && c.Oversample != f.config.Oversample
+	if c.SampleRate > 0 && (rateChanged || oversampleChanged) {
+		f.setSampleRate(float64(c.SampleRate), int(c.Oversample))
+		f.sampleRate = c.SampleRate
+		f.oversample = c.Oversample
+	}
+	host, rf := f.device.GetSampleRate()
+	applied.SampleRate = float32(host)
+	if host > 0 {
+		applied.Oversample = uint32(math.Round(rf / host))
+	}
 
 	for i, n := range c.RXC {
 		o := &protocol.ChannelConfig{}
@@ -95,12 +134,56 @@ func (f *LimeSDRFrontend) SetDeviceConfig(c *protocol.DeviceConfig) protocol.Dev
 			f.device.SetCenterFrequency(i, true, float64(n.CenterFrequency))
 			limeLog.Info("Channel %d: Tuning center frequency: %v", i, n.CenterFrequency)
 		}
+
+		if n.AnalogFilterBandwidth > 0 && n.AnalogFilterBandwidth != o.AnalogFilterBandwidth {
+			f.device.SetLPF(i, true, float64(n.AnalogFilterBandwidth))
+			n.AnalogFilterBandwidth = float32(f.device.GetLPF(i, true))
+			limeLog.Info("Channel %d: Tuning analog filter bandwidth: %v", i, n.AnalogFilterBandwidth)
+		}
+
+		if n.DigitalFilterBandwidth != o.DigitalFilterBandwidth {
+			if n.DigitalFilterBandwidth > 0 {
+				f.device.SetDigitalFilter(i, true, float64(n.DigitalFilterBandwidth))
+				f.device.EnableDigitalFilter(i, true)
+			} else {
+				f.device.DisableDigitalFilter(i, true)
+			}
+			limeLog.Info("Channel %d: Tuning digital filter bandwidth: %v", i, n.DigitalFilterBandwidth)
+		}
 	}
 
-  f.config = c
+	f.config = &applied
 	return *f.config
 }
 
+// setSampleRate changes the sample rate of the device, pausing its stream meanwhile
+func (f *LimeSDRFrontend) setSampleRate(sampleRate float64, oversample int) {
+	if f.running {
+		f.device.Stop()
+	}
+
+	f.device.SetSampleRate(sampleRate, oversample)
+	limeLog.Info("Sample rate: %v (oversample %d)", sampleRate, oversample)
+
+	if f.running {
+		f.device.Start()
+	}
+}
+
+// Calibrate runs the LimeSuite calibration of a receive channel for bandwidth, or the sample rate when zero
+func (f *LimeSDRFrontend) Calibrate(channel int, bandwidth float64) error {
+	if channel < 0 || channel >= len(f.device.RXChannels) {
+		return fmt.Errorf("invalid channel %d", channel)
+	}
+
+	if bandwidth <= 0 {
+		bandwidth = float64(f.config.SampleRate)
+	}
+
+	limeLog.Info("Channel %d: Calibrating for %v Hz", channel, bandwidth)
+	return f.device.Calibrate(channel, true, bandwidth)
+}
+
 func (f *LimeSDRFrontend) Start() {
 	if !f.running {
 		limeLog.Info("Starting")
@@ -116,6 +199,7 @@ func (f *LimeSDRFrontend) Stop() {
     time.Sleep(time.Second)
     f.device.Close()
     f.running = false
+		f.closed = true
 	}
 }
 
@@ -127,6 +211,14 @@ func (f *LimeSDRFrontend) Init() bool {
 	return true
 }
 
+// Destroy closes the device, which Stop already does when it was started
 func (f *LimeSDRFrontend) Destroy() {
-
+	if f.running {
+		f.Stop()
+		return
+	}
+	if !f.closed {
+		f.device.Close()
+		f.closed = true
+	}
 }
@@ -24,7 +24,14 @@ type Frontend interface {
 	SetSamplesAvailableCallback(cb SamplesCallback)
 }
 
-type SamplesCallback func(samples []complex64)
+// Calibrator is implemented by the frontends able to run a hardware calibration of a receive channel
+type Calibrator interface {
+	Calibrate(channel int, bandwidth float64) error
+}
+
+// SamplesCallback receives the samples from a frontend.
+// hardwareIndex is the device sample counter value of the first sample.
+type SamplesCallback func(samples []complex64, hardwareIndex uint64)
 
 type Frontends map[string]func(*protocol.DeviceState) Frontend
 type Find map[string]func(*protocol.DeviceList)
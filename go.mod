@@ -7,11 +7,11 @@ require (
 	github.com/alecthomas/units v0.0.0-20151022065526-2efee857e7cf // indirect
 	github.com/gofrs/uuid v3.2.0+incompatible
 	github.com/golang/protobuf v1.3.1
+	github.com/logrusorgru/aurora v0.0.0-20181002194514-a7b3b318ed4e
 	github.com/mattn/go-pointer v0.0.0-20180825124634-49522c3f3791 // indirect
 	github.com/myriadrf/limedrv v0.0.0-20190225221912-8583a26e3fce
 	github.com/quan-to/slog v0.0.0-20190317205605-56a2b4159924
 	github.com/racerxdl/fastconvert v0.0.0-20190129064530-871b6f6cd82a // indirect
-	github.com/racerxdl/go.fifo v0.0.0-20180604061744-c6aa83afe374
 	github.com/racerxdl/segdsp v0.0.0-20190321214158-1cd3e325e91a
 	github.com/racerxdl/spy2go v0.0.0-20190103011754-14102c047be5
 	github.com/stretchr/testify v1.3.0 // indirect
@@ -19,3 +19,6 @@ require (
 	google.golang.org/grpc v1.19.1
 	gopkg.in/alecthomas/kingpin.v2 v2.2.6
 )
+
+// limedrv 8583a26e3fce with LMSDevice.Calibrate added, see third_party/README.md
+replace github.com/myriadrf/limedrv => ./third_party/limedrv
@@ -0,0 +1,123 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// SampleSize returns the size in bytes of a single complex sample in the format
+func (f SampleFormat) SampleSize() int {
+	switch f {
+	case SampleFormat_CS16:
+		return 4
+	case SampleFormat_CS8, SampleFormat_CU8:
+		return 2
+	default:
+		return 8
+	}
+}
+
+// SigMFDatatype returns the SigMF core:datatype string for the format
+func (f SampleFormat) SigMFDatatype() string {
+	switch f {
+	case SampleFormat_CS16:
+		return "ci16_le"
+	case SampleFormat_CS8:
+		return "ci8"
+	case SampleFormat_CU8:
+		return "cu8"
+	default:
+		return "cf32_le"
+	}
+}
+
+// SampleFormatFromSigMF returns the format matching a SigMF core:datatype string
+func SampleFormatFromSigMF(datatype string) (SampleFormat, error) {
+	for _, f := range []SampleFormat{SampleFormat_CF32, SampleFormat_CS16, SampleFormat_CS8, SampleFormat_CU8} {
+		if f.SigMFDatatype() == datatype {
+			return f, nil
+		}
+	}
+
+	return SampleFormat_CF32, fmt.Errorf("unsupported datatype %s", datatype)
+}
+
+// Encode converts the samples to the format, reusing dst if it is big enough.
+// Integer formats are scaled so that 1.0 maps to full scale and clipped.
+func (f SampleFormat) Encode(dst []byte, samples []complex64) []byte {
+	size := len(samples) * f.SampleSize()
+	if cap(dst) < size {
+		dst = make([]byte, size)
+	}
+	dst = dst[:size]
+
+	switch f {
+	case SampleFormat_CS16:
+		for i, c := range samples {
+			binary.LittleEndian.PutUint16(dst[i*4:], uint16(int16(clip(real(c)*32767, -32768, 32767))))
+			binary.LittleEndian.PutUint16(dst[i*4+2:], uint16(int16(clip(imag(c)*32767, -32768, 32767))))
+		}
+	case SampleFormat_CS8:
+		for i, c := range samples {
+			dst[i*2] = uint8(int8(clip(real(c)*127, -128, 127)))
+			dst[i*2+1] = uint8(int8(clip(imag(c)*127, -128, 127)))
+		}
+	case SampleFormat_CU8:
+		for i, c := range samples {
+			dst[i*2] = uint8(clip(real(c)*127.5+127.5, 0, 255))
+			dst[i*2+1] = uint8(clip(imag(c)*127.5+127.5, 0, 255))
+		}
+	default:
+		for i, c := range samples {
+			binary.LittleEndian.PutUint32(dst[i*8:], math.Float32bits(real(c)))
+			binary.LittleEndian.PutUint32(dst[i*8+4:], math.Float32bits(imag(c)))
+		}
+	}
+
+	return dst
+}
+
+// Decode converts data in the format back to complex samples, reusing dst if it is big enough.
+func (f SampleFormat) Decode(dst []complex64, data []byte) []complex64 {
+	n := len(data) / f.SampleSize()
+	if cap(dst) < n {
+		dst = make([]complex64, n)
+	}
+	dst = dst[:n]
+
+	switch f {
+	case SampleFormat_CS16:
+		for i := range dst {
+			re := int16(binary.LittleEndian.Uint16(data[i*4:]))
+			im := int16(binary.LittleEndian.Uint16(data[i*4+2:]))
+			dst[i] = complex(float32(re)/32767, float32(im)/32767)
+		}
+	case SampleFormat_CS8:
+		for i := range dst {
+			dst[i] = complex(float32(int8(data[i*2]))/127, float32(int8(data[i*2+1]))/127)
+		}
+	case SampleFormat_CU8:
+		for i := range dst {
+			dst[i] = complex((float32(data[i*2])-127.5)/127.5, (float32(data[i*2+1])-127.5)/127.5)
+		}
+	default:
+		for i := range dst {
+			re := math.Float32frombits(binary.LittleEndian.Uint32(data[i*8:]))
+			im := math.Float32frombits(binary.LittleEndian.Uint32(data[i*8+4:]))
+			dst[i] = complex(re, im)
+		}
+	}
+
+	return dst
+}
+
+func clip(v, min, max float32) float32 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
@@ -0,0 +1,85 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"math/cmplx"
+	"testing"
+)
+
+func TestSampleFormatRoundTrip(t *testing.T) {
+	samples := []complex64{0, 0.5 - 0.25i, -0.5 + 0.75i, -1 - 1i, 1 + 1i, -0.001 + 0.001i}
+
+	tests := []struct {
+		format    SampleFormat
+		tolerance float64
+	}{
+		{SampleFormat_CF32, 0},
+		{SampleFormat_CS16, 1.0 / 32767},
+		{SampleFormat_CS8, 1.0 / 127},
+		{SampleFormat_CU8, 1.0 / 127.5},
+	}
+
+	for _, tt := range tests {
+		data := tt.format.Encode(nil, samples)
+		if len(data) != len(samples)*tt.format.SampleSize() {
+			t.Errorf("%s: encoded %d bytes, want %d", tt.format, len(data), len(samples)*tt.format.SampleSize())
+			continue
+		}
+
+		decoded := tt.format.Decode(nil, data)
+		if len(decoded) != len(samples) {
+			t.Errorf("%s: decoded %d samples, want %d", tt.format, len(decoded), len(samples))
+			continue
+		}
+
+		for i, want := range samples {
+			if d := cmplx.Abs(complex128(decoded[i] - want)); d > tt.tolerance*1.5 {
+				t.Errorf("%s: sample %d decoded as %v, want %v", tt.format, i, decoded[i], want)
+			}
+		}
+	}
+}
+
+func TestSampleFormatClipping(t *testing.T) {
+	samples := []complex64{2 - 2i}
+
+	tests := []struct {
+		format SampleFormat
+		want   complex64
+	}{
+		{SampleFormat_CS16, complex(1, -32768.0/32767)},
+		{SampleFormat_CS8, complex(1, -128.0/127)},
+		{SampleFormat_CU8, 1 - 1i},
+	}
+
+	for _, tt := range tests {
+		got := tt.format.Decode(nil, tt.format.Encode(nil, samples))[0]
+		if cmplx.Abs(complex128(got-tt.want)) > 1e-6 {
+			t.Errorf("%s: clipped to %v, want %v", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestSampleFormatCS16Negative(t *testing.T) {
+	data := SampleFormat_CS16.Encode(nil, []complex64{-1 - 0.5i})
+
+	if v := int16(binary.LittleEndian.Uint16(data)); v != -32767 {
+		t.Errorf("I encoded as %d, want -32767", v)
+	}
+	if v := int16(binary.LittleEndian.Uint16(data[2:])); v != -16383 {
+		t.Errorf("Q encoded as %d, want -16383", v)
+	}
+}
+
+func TestSampleFormatSigMFDatatype(t *testing.T) {
+	for _, f := range []SampleFormat{SampleFormat_CF32, SampleFormat_CS16, SampleFormat_CS8, SampleFormat_CU8} {
+		got, err := SampleFormatFromSigMF(f.SigMFDatatype())
+		if err != nil || got != f {
+			t.Errorf("%s: datatype %s read back as %s (%v)", f, f.SigMFDatatype(), got, err)
+		}
+	}
+
+	if _, err := SampleFormatFromSigMF("ri16_be"); err == nil {
+		t.Error("unsupported datatype accepted")
+	}
+}
@@ -89,6 +89,179 @@ func (StatusType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ad098daeda4239f7, []int{1}
 }
 
+type BlockType int32
+
+const (
+	BlockType_TranslatorBlock  BlockType = 0
+	BlockType_DecimatorBlock   BlockType = 1
+	BlockType_ResamplerBlock   BlockType = 2
+	BlockType_FilterBlock      BlockType = 3
+	BlockType_DemodulatorBlock BlockType = 4
+	BlockType_FFTBlock         BlockType = 5
+	BlockType_SquelchBlock     BlockType = 6
+	BlockType_SinkBlock        BlockType = 7
+)
+
+var BlockType_name = map[int32]string{
+	0: "TranslatorBlock",
+	1: "DecimatorBlock",
+	2: "ResamplerBlock",
+	3: "FilterBlock",
+	4: "DemodulatorBlock",
+	5: "FFTBlock",
+	6: "SquelchBlock",
+	7: "SinkBlock",
+}
+
+var BlockType_value = map[string]int32{
+	"TranslatorBlock":  0,
+	"DecimatorBlock":   1,
+	"ResamplerBlock":   2,
+	"FilterBlock":      3,
+	"DemodulatorBlock": 4,
+	"FFTBlock":         5,
+	"SquelchBlock":     6,
+	"SinkBlock":        7,
+}
+
+func (x BlockType) String() string {
+	return proto.EnumName(BlockType_name, int32(x))
+}
+
+func (BlockType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{2}
+}
+
+type DemodMode int32
+
+const (
+	DemodMode_FMDemod DemodMode = 0
+	DemodMode_AMDemod DemodMode = 1
+)
+
+var DemodMode_name = map[int32]string{
+	0: "FMDemod",
+	1: "AMDemod",
+}
+
+var DemodMode_value = map[string]int32{
+	"FMDemod": 0,
+	"AMDemod": 1,
+}
+
+func (x DemodMode) String() string {
+	return proto.EnumName(DemodMode_name, int32(x))
+}
+
+func (DemodMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{3}
+}
+
+type SampleKind int32
+
+const (
+	SampleKind_IQSamples       SampleKind = 0
+	SampleKind_AudioSamples    SampleKind = 1
+	SampleKind_SpectrumSamples SampleKind = 2
+)
+
+var SampleKind_name = map[int32]string{
+	0: "IQSamples",
+	1: "AudioSamples",
+	2: "SpectrumSamples",
+}
+
+var SampleKind_value = map[string]int32{
+	"IQSamples":       0,
+	"AudioSamples":    1,
+	"SpectrumSamples": 2,
+}
+
+func (x SampleKind) String() string {
+	return proto.EnumName(SampleKind_name, int32(x))
+}
+
+func (SampleKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{4}
+}
+
+type SampleFormat int32
+
+const (
+	SampleFormat_CF32 SampleFormat = 0
+	SampleFormat_CS16 SampleFormat = 1
+	SampleFormat_CS8  SampleFormat = 2
+	SampleFormat_CU8  SampleFormat = 3
+)
+
+var SampleFormat_name = map[int32]string{
+	0: "CF32",
+	1: "CS16",
+	2: "CS8",
+	3: "CU8",
+}
+
+var SampleFormat_value = map[string]int32{
+	"CF32": 0,
+	"CS16": 1,
+	"CS8":  2,
+	"CU8":  3,
+}
+
+func (x SampleFormat) String() string {
+	return proto.EnumName(SampleFormat_name, int32(x))
+}
+
+func (SampleFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{5}
+}
+
+type CommandType int32
+
+const (
+	CommandType_NoCommand       CommandType = 0
+	CommandType_StartIQ         CommandType = 1
+	CommandType_StopIQ          CommandType = 2
+	CommandType_TuneDevice      CommandType = 3
+	CommandType_SetChain        CommandType = 4
+	CommandType_ProvisionDevice CommandType = 5
+	CommandType_DestroySession  CommandType = 6
+	CommandType_SetGain         CommandType = 7
+	CommandType_SetChannel      CommandType = 8
+)
+
+var CommandType_name = map[int32]string{
+	0: "NoCommand",
+	1: "StartIQ",
+	2: "StopIQ",
+	3: "TuneDevice",
+	4: "SetChain",
+	5: "ProvisionDevice",
+	6: "DestroySession",
+	7: "SetGain",
+	8: "SetChannel",
+}
+
+var CommandType_value = map[string]int32{
+	"NoCommand":       0,
+	"StartIQ":         1,
+	"StopIQ":          2,
+	"TuneDevice":      3,
+	"SetChain":        4,
+	"ProvisionDevice": 5,
+	"DestroySession":  6,
+	"SetGain":         7,
+	"SetChannel":      8,
+}
+
+func (x CommandType) String() string {
+	return proto.EnumName(CommandType_name, int32(x))
+}
+
+func (CommandType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{6}
+}
+
 type Session struct {
 	Token                string   `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -326,11 +499,12 @@ func (m *DeviceConfig) GetTXC() []*ChannelConfig {
 }
 
 type DeviceState struct {
-	Info                 *DeviceInfo   `protobuf:"bytes,1,opt,name=Info,proto3" json:"Info,omitempty"`
-	Config               *DeviceConfig `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Info                 *DeviceInfo    `protobuf:"bytes,1,opt,name=Info,proto3" json:"Info,omitempty"`
+	Config               *DeviceConfig  `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
+	Chain                []*BlockConfig `protobuf:"bytes,3,rep,name=Chain,proto3" json:"Chain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *DeviceState) Reset()         { *m = DeviceState{} }
@@ -372,6 +546,13 @@ func (m *DeviceState) GetConfig() *DeviceConfig {
 	return nil
 }
 
+func (m *DeviceState) GetChain() []*BlockConfig {
+	if m != nil {
+		return m.Chain
+	}
+	return nil
+}
+
 type DeviceTune struct {
 	Session              *Session      `protobuf:"bytes,1,opt,name=Session,proto3" json:"Session,omitempty"`
 	Config               *DeviceConfig `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
@@ -425,6 +606,14 @@ type ChannelConfig struct {
 	AnalogFilterBandwidth  float32  `protobuf:"fixed32,3,opt,name=AnalogFilterBandwidth,proto3" json:"AnalogFilterBandwidth,omitempty"`
 	DigitalFilterBandwidth float32  `protobuf:"fixed32,4,opt,name=DigitalFilterBandwidth,proto3" json:"DigitalFilterBandwidth,omitempty"`
 	Antenna                string   `protobuf:"bytes,5,opt,name=Antenna,proto3" json:"Antenna,omitempty"`
+	DCCorrection           bool     `protobuf:"varint,6,opt,name=DCCorrection,proto3" json:"DCCorrection,omitempty"`
+	IQCorrection           bool     `protobuf:"varint,7,opt,name=IQCorrection,proto3" json:"IQCorrection,omitempty"`
+	DigitalGain            float32  `protobuf:"fixed32,8,opt,name=DigitalGain,proto3" json:"DigitalGain,omitempty"`
+	AGC                    bool     `protobuf:"varint,9,opt,name=AGC,proto3" json:"AGC,omitempty"`
+	AGCAttack              float32  `protobuf:"fixed32,10,opt,name=AGCAttack,proto3" json:"AGCAttack,omitempty"`
+	AGCDecay               float32  `protobuf:"fixed32,11,opt,name=AGCDecay,proto3" json:"AGCDecay,omitempty"`
+	AGCReference           float32  `protobuf:"fixed32,12,opt,name=AGCReference,proto3" json:"AGCReference,omitempty"`
+	AGCMaxGain             float32  `protobuf:"fixed32,13,opt,name=AGCMaxGain,proto3" json:"AGCMaxGain,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
 	XXX_unrecognized       []byte   `json:"-"`
 	XXX_sizecache          int32    `json:"-"`
@@ -490,528 +679,3421 @@ func (m *ChannelConfig) GetAntenna() string {
 	return ""
 }
 
-type IQData struct {
-	Timestamp            uint64     `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Status               StatusType `protobuf:"varint,2,opt,name=status,proto3,enum=protocol.StatusType" json:"status,omitempty"`
-	Samples              []float32  `protobuf:"fixed32,4,rep,packed,name=Samples,proto3" json:"Samples,omitempty"`
-	Error                string     `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *ChannelConfig) GetDCCorrection() bool {
+	if m != nil {
+		return m.DCCorrection
+	}
+	return false
 }
 
-func (m *IQData) Reset()         { *m = IQData{} }
-func (m *IQData) String() string { return proto.CompactTextString(m) }
-func (*IQData) ProtoMessage()    {}
-func (*IQData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{7}
+func (m *ChannelConfig) GetIQCorrection() bool {
+	if m != nil {
+		return m.IQCorrection
+	}
+	return false
 }
 
-func (m *IQData) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IQData.Unmarshal(m, b)
-}
-func (m *IQData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IQData.Marshal(b, m, deterministic)
-}
-func (m *IQData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IQData.Merge(m, src)
-}
-func (m *IQData) XXX_Size() int {
-	return xxx_messageInfo_IQData.Size(m)
-}
-func (m *IQData) XXX_DiscardUnknown() {
-	xxx_messageInfo_IQData.DiscardUnknown(m)
+func (m *ChannelConfig) GetDigitalGain() float32 {
+	if m != nil {
+		return m.DigitalGain
+	}
+	return 0
 }
 
-var xxx_messageInfo_IQData proto.InternalMessageInfo
+func (m *ChannelConfig) GetAGC() bool {
+	if m != nil {
+		return m.AGC
+	}
+	return false
+}
 
-func (m *IQData) GetTimestamp() uint64 {
+func (m *ChannelConfig) GetAGCAttack() float32 {
 	if m != nil {
-		return m.Timestamp
+		return m.AGCAttack
 	}
 	return 0
 }
 
-func (m *IQData) GetStatus() StatusType {
+func (m *ChannelConfig) GetAGCDecay() float32 {
 	if m != nil {
-		return m.Status
+		return m.AGCDecay
 	}
-	return StatusType_Invalid
+	return 0
 }
 
-func (m *IQData) GetSamples() []float32 {
+func (m *ChannelConfig) GetAGCReference() float32 {
 	if m != nil {
-		return m.Samples
+		return m.AGCReference
 	}
-	return nil
+	return 0
 }
 
-func (m *IQData) GetError() string {
+func (m *ChannelConfig) GetAGCMaxGain() float32 {
 	if m != nil {
-		return m.Error
+		return m.AGCMaxGain
 	}
-	return ""
+	return 0
 }
 
-type Version struct {
-	Major                uint32   `protobuf:"varint,1,opt,name=Major,proto3" json:"Major,omitempty"`
-	Minor                uint32   `protobuf:"varint,2,opt,name=Minor,proto3" json:"Minor,omitempty"`
-	Hash                 uint32   `protobuf:"varint,3,opt,name=Hash,proto3" json:"Hash,omitempty"`
+type IQCorrectionState struct {
+	Channel              uint32   `protobuf:"varint,1,opt,name=Channel,proto3" json:"Channel,omitempty"`
+	DCCorrection         bool     `protobuf:"varint,2,opt,name=DCCorrection,proto3" json:"DCCorrection,omitempty"`
+	IQCorrection         bool     `protobuf:"varint,3,opt,name=IQCorrection,proto3" json:"IQCorrection,omitempty"`
+	DCOffsetI            float32  `protobuf:"fixed32,4,opt,name=DCOffsetI,proto3" json:"DCOffsetI,omitempty"`
+	DCOffsetQ            float32  `protobuf:"fixed32,5,opt,name=DCOffsetQ,proto3" json:"DCOffsetQ,omitempty"`
+	GainImbalance        float32  `protobuf:"fixed32,6,opt,name=GainImbalance,proto3" json:"GainImbalance,omitempty"`
+	PhaseImbalance       float32  `protobuf:"fixed32,7,opt,name=PhaseImbalance,proto3" json:"PhaseImbalance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Version) Reset()         { *m = Version{} }
-func (m *Version) String() string { return proto.CompactTextString(m) }
-func (*Version) ProtoMessage()    {}
-func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{8}
+func (m *IQCorrectionState) Reset()         { *m = IQCorrectionState{} }
+func (m *IQCorrectionState) String() string { return proto.CompactTextString(m) }
+func (*IQCorrectionState) ProtoMessage()    {}
+func (*IQCorrectionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{7}
 }
 
-func (m *Version) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Version.Unmarshal(m, b)
+func (m *IQCorrectionState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IQCorrectionState.Unmarshal(m, b)
 }
-func (m *Version) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Version.Marshal(b, m, deterministic)
+func (m *IQCorrectionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IQCorrectionState.Marshal(b, m, deterministic)
 }
-func (m *Version) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Version.Merge(m, src)
+func (m *IQCorrectionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IQCorrectionState.Merge(m, src)
 }
-func (m *Version) XXX_Size() int {
-	return xxx_messageInfo_Version.Size(m)
+func (m *IQCorrectionState) XXX_Size() int {
+	return xxx_messageInfo_IQCorrectionState.Size(m)
 }
-func (m *Version) XXX_DiscardUnknown() {
-	xxx_messageInfo_Version.DiscardUnknown(m)
+func (m *IQCorrectionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_IQCorrectionState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Version proto.InternalMessageInfo
+var xxx_messageInfo_IQCorrectionState proto.InternalMessageInfo
 
-func (m *Version) GetMajor() uint32 {
+func (m *IQCorrectionState) GetChannel() uint32 {
 	if m != nil {
-		return m.Major
+		return m.Channel
 	}
 	return 0
 }
 
-func (m *Version) GetMinor() uint32 {
+func (m *IQCorrectionState) GetDCCorrection() bool {
 	if m != nil {
-		return m.Minor
+		return m.DCCorrection
+	}
+	return false
+}
+
+func (m *IQCorrectionState) GetIQCorrection() bool {
+	if m != nil {
+		return m.IQCorrection
+	}
+	return false
+}
+
+func (m *IQCorrectionState) GetDCOffsetI() float32 {
+	if m != nil {
+		return m.DCOffsetI
 	}
 	return 0
 }
 
-func (m *Version) GetHash() uint32 {
+func (m *IQCorrectionState) GetDCOffsetQ() float32 {
 	if m != nil {
-		return m.Hash
+		return m.DCOffsetQ
 	}
 	return 0
 }
 
-type ServerInfoData struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Version              *Version `protobuf:"bytes,2,opt,name=Version,proto3" json:"Version,omitempty"`
+func (m *IQCorrectionState) GetGainImbalance() float32 {
+	if m != nil {
+		return m.GainImbalance
+	}
+	return 0
+}
+
+func (m *IQCorrectionState) GetPhaseImbalance() float32 {
+	if m != nil {
+		return m.PhaseImbalance
+	}
+	return 0
+}
+
+type IQCorrectionList struct {
+	Channels             []*IQCorrectionState `protobuf:"bytes,1,rep,name=Channels,proto3" json:"Channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *IQCorrectionList) Reset()         { *m = IQCorrectionList{} }
+func (m *IQCorrectionList) String() string { return proto.CompactTextString(m) }
+func (*IQCorrectionList) ProtoMessage()    {}
+func (*IQCorrectionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{8}
+}
+
+func (m *IQCorrectionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IQCorrectionList.Unmarshal(m, b)
+}
+func (m *IQCorrectionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IQCorrectionList.Marshal(b, m, deterministic)
+}
+func (m *IQCorrectionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IQCorrectionList.Merge(m, src)
+}
+func (m *IQCorrectionList) XXX_Size() int {
+	return xxx_messageInfo_IQCorrectionList.Size(m)
+}
+func (m *IQCorrectionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_IQCorrectionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IQCorrectionList proto.InternalMessageInfo
+
+func (m *IQCorrectionList) GetChannels() []*IQCorrectionState {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+type CalibrationRequest struct {
+	Session              *Session `protobuf:"bytes,1,opt,name=Session,proto3" json:"Session,omitempty"`
+	Channel              uint32   `protobuf:"varint,2,opt,name=Channel,proto3" json:"Channel,omitempty"`
+	Bandwidth            float32  `protobuf:"fixed32,3,opt,name=Bandwidth,proto3" json:"Bandwidth,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ServerInfoData) Reset()         { *m = ServerInfoData{} }
-func (m *ServerInfoData) String() string { return proto.CompactTextString(m) }
-func (*ServerInfoData) ProtoMessage()    {}
-func (*ServerInfoData) Descriptor() ([]byte, []int) {
+func (m *CalibrationRequest) Reset()         { *m = CalibrationRequest{} }
+func (m *CalibrationRequest) String() string { return proto.CompactTextString(m) }
+func (*CalibrationRequest) ProtoMessage()    {}
+func (*CalibrationRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ad098daeda4239f7, []int{9}
 }
 
-func (m *ServerInfoData) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ServerInfoData.Unmarshal(m, b)
+func (m *CalibrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalibrationRequest.Unmarshal(m, b)
 }
-func (m *ServerInfoData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ServerInfoData.Marshal(b, m, deterministic)
+func (m *CalibrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalibrationRequest.Marshal(b, m, deterministic)
 }
-func (m *ServerInfoData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ServerInfoData.Merge(m, src)
+func (m *CalibrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalibrationRequest.Merge(m, src)
 }
-func (m *ServerInfoData) XXX_Size() int {
-	return xxx_messageInfo_ServerInfoData.Size(m)
+func (m *CalibrationRequest) XXX_Size() int {
+	return xxx_messageInfo_CalibrationRequest.Size(m)
 }
-func (m *ServerInfoData) XXX_DiscardUnknown() {
-	xxx_messageInfo_ServerInfoData.DiscardUnknown(m)
+func (m *CalibrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalibrationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ServerInfoData proto.InternalMessageInfo
+var xxx_messageInfo_CalibrationRequest proto.InternalMessageInfo
 
-func (m *ServerInfoData) GetName() string {
+func (m *CalibrationRequest) GetSession() *Session {
 	if m != nil {
-		return m.Name
+		return m.Session
 	}
-	return ""
+	return nil
 }
 
-func (m *ServerInfoData) GetVersion() *Version {
+func (m *CalibrationRequest) GetChannel() uint32 {
 	if m != nil {
-		return m.Version
+		return m.Channel
 	}
-	return nil
+	return 0
 }
 
-type Empty struct {
+func (m *CalibrationRequest) GetBandwidth() float32 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
+type FrequencyCorrection struct {
+	Serial               string   `protobuf:"bytes,1,opt,name=Serial,proto3" json:"Serial,omitempty"`
+	PPM                  float64  `protobuf:"fixed64,2,opt,name=PPM,proto3" json:"PPM,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Empty) Reset()         { *m = Empty{} }
-func (m *Empty) String() string { return proto.CompactTextString(m) }
-func (*Empty) ProtoMessage()    {}
-func (*Empty) Descriptor() ([]byte, []int) {
+func (m *FrequencyCorrection) Reset()         { *m = FrequencyCorrection{} }
+func (m *FrequencyCorrection) String() string { return proto.CompactTextString(m) }
+func (*FrequencyCorrection) ProtoMessage()    {}
+func (*FrequencyCorrection) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ad098daeda4239f7, []int{10}
 }
 
-func (m *Empty) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Empty.Unmarshal(m, b)
+func (m *FrequencyCorrection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FrequencyCorrection.Unmarshal(m, b)
 }
-func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+func (m *FrequencyCorrection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FrequencyCorrection.Marshal(b, m, deterministic)
 }
-func (m *Empty) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Empty.Merge(m, src)
+func (m *FrequencyCorrection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrequencyCorrection.Merge(m, src)
 }
-func (m *Empty) XXX_Size() int {
-	return xxx_messageInfo_Empty.Size(m)
+func (m *FrequencyCorrection) XXX_Size() int {
+	return xxx_messageInfo_FrequencyCorrection.Size(m)
 }
-func (m *Empty) XXX_DiscardUnknown() {
-	xxx_messageInfo_Empty.DiscardUnknown(m)
+func (m *FrequencyCorrection) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrequencyCorrection.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Empty proto.InternalMessageInfo
+var xxx_messageInfo_FrequencyCorrection proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("protocol.DeviceName", DeviceName_name, DeviceName_value)
-	proto.RegisterEnum("protocol.StatusType", StatusType_name, StatusType_value)
-	proto.RegisterType((*Session)(nil), "protocol.Session")
-	proto.RegisterType((*DeviceInfo)(nil), "protocol.DeviceInfo")
-	proto.RegisterType((*DeviceList)(nil), "protocol.DeviceList")
-	proto.RegisterType((*DeviceConfig)(nil), "protocol.DeviceConfig")
-	proto.RegisterType((*DeviceState)(nil), "protocol.DeviceState")
-	proto.RegisterType((*DeviceTune)(nil), "protocol.DeviceTune")
-	proto.RegisterType((*ChannelConfig)(nil), "protocol.ChannelConfig")
-	proto.RegisterType((*IQData)(nil), "protocol.IQData")
-	proto.RegisterType((*Version)(nil), "protocol.Version")
-	proto.RegisterType((*ServerInfoData)(nil), "protocol.ServerInfoData")
-	proto.RegisterType((*Empty)(nil), "protocol.Empty")
+func (m *FrequencyCorrection) GetSerial() string {
+	if m != nil {
+		return m.Serial
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("server.proto", fileDescriptor_ad098daeda4239f7) }
+func (m *FrequencyCorrection) GetPPM() float64 {
+	if m != nil {
+		return m.PPM
+	}
+	return 0
+}
+
+type FrequencyCorrectionList struct {
+	Corrections          []*FrequencyCorrection `protobuf:"bytes,1,rep,name=Corrections,proto3" json:"Corrections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *FrequencyCorrectionList) Reset()         { *m = FrequencyCorrectionList{} }
+func (m *FrequencyCorrectionList) String() string { return proto.CompactTextString(m) }
+func (*FrequencyCorrectionList) ProtoMessage()    {}
+func (*FrequencyCorrectionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{11}
+}
+
+func (m *FrequencyCorrectionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FrequencyCorrectionList.Unmarshal(m, b)
+}
+func (m *FrequencyCorrectionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FrequencyCorrectionList.Marshal(b, m, deterministic)
+}
+func (m *FrequencyCorrectionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrequencyCorrectionList.Merge(m, src)
+}
+func (m *FrequencyCorrectionList) XXX_Size() int {
+	return xxx_messageInfo_FrequencyCorrectionList.Size(m)
+}
+func (m *FrequencyCorrectionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrequencyCorrectionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrequencyCorrectionList proto.InternalMessageInfo
+
+func (m *FrequencyCorrectionList) GetCorrections() []*FrequencyCorrection {
+	if m != nil {
+		return m.Corrections
+	}
+	return nil
+}
+
+type PPMEstimateRequest struct {
+	Session              *Session `protobuf:"bytes,1,opt,name=Session,proto3" json:"Session,omitempty"`
+	ReferenceFrequency   float64  `protobuf:"fixed64,2,opt,name=ReferenceFrequency,proto3" json:"ReferenceFrequency,omitempty"`
+	Duration             float64  `protobuf:"fixed64,3,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	SearchSpan           float32  `protobuf:"fixed32,4,opt,name=SearchSpan,proto3" json:"SearchSpan,omitempty"`
+	Apply                bool     `protobuf:"varint,5,opt,name=Apply,proto3" json:"Apply,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PPMEstimateRequest) Reset()         { *m = PPMEstimateRequest{} }
+func (m *PPMEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*PPMEstimateRequest) ProtoMessage()    {}
+func (*PPMEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{12}
+}
+
+func (m *PPMEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PPMEstimateRequest.Unmarshal(m, b)
+}
+func (m *PPMEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PPMEstimateRequest.Marshal(b, m, deterministic)
+}
+func (m *PPMEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PPMEstimateRequest.Merge(m, src)
+}
+func (m *PPMEstimateRequest) XXX_Size() int {
+	return xxx_messageInfo_PPMEstimateRequest.Size(m)
+}
+func (m *PPMEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PPMEstimateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PPMEstimateRequest proto.InternalMessageInfo
+
+func (m *PPMEstimateRequest) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+func (m *PPMEstimateRequest) GetReferenceFrequency() float64 {
+	if m != nil {
+		return m.ReferenceFrequency
+	}
+	return 0
+}
+
+func (m *PPMEstimateRequest) GetDuration() float64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *PPMEstimateRequest) GetSearchSpan() float32 {
+	if m != nil {
+		return m.SearchSpan
+	}
+	return 0
+}
+
+func (m *PPMEstimateRequest) GetApply() bool {
+	if m != nil {
+		return m.Apply
+	}
+	return false
+}
+
+type PPMEstimate struct {
+	Correction           *FrequencyCorrection `protobuf:"bytes,1,opt,name=Correction,proto3" json:"Correction,omitempty"`
+	MeasuredFrequency    float64              `protobuf:"fixed64,2,opt,name=MeasuredFrequency,proto3" json:"MeasuredFrequency,omitempty"`
+	SNR                  float32              `protobuf:"fixed32,3,opt,name=SNR,proto3" json:"SNR,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PPMEstimate) Reset()         { *m = PPMEstimate{} }
+func (m *PPMEstimate) String() string { return proto.CompactTextString(m) }
+func (*PPMEstimate) ProtoMessage()    {}
+func (*PPMEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{13}
+}
+
+func (m *PPMEstimate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PPMEstimate.Unmarshal(m, b)
+}
+func (m *PPMEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PPMEstimate.Marshal(b, m, deterministic)
+}
+func (m *PPMEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PPMEstimate.Merge(m, src)
+}
+func (m *PPMEstimate) XXX_Size() int {
+	return xxx_messageInfo_PPMEstimate.Size(m)
+}
+func (m *PPMEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PPMEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PPMEstimate proto.InternalMessageInfo
+
+func (m *PPMEstimate) GetCorrection() *FrequencyCorrection {
+	if m != nil {
+		return m.Correction
+	}
+	return nil
+}
+
+func (m *PPMEstimate) GetMeasuredFrequency() float64 {
+	if m != nil {
+		return m.MeasuredFrequency
+	}
+	return 0
+}
+
+func (m *PPMEstimate) GetSNR() float32 {
+	if m != nil {
+		return m.SNR
+	}
+	return 0
+}
+
+type BlockConfig struct {
+	Type                 BlockType `protobuf:"varint,1,opt,name=Type,proto3,enum=protocol.BlockType" json:"Type,omitempty"`
+	Frequency            float32   `protobuf:"fixed32,2,opt,name=Frequency,proto3" json:"Frequency,omitempty"`
+	Decimation           uint32    `protobuf:"varint,3,opt,name=Decimation,proto3" json:"Decimation,omitempty"`
+	Interpolation        uint32    `protobuf:"varint,4,opt,name=Interpolation,proto3" json:"Interpolation,omitempty"`
+	Bandwidth            float32   `protobuf:"fixed32,5,opt,name=Bandwidth,proto3" json:"Bandwidth,omitempty"`
+	Transition           float32   `protobuf:"fixed32,6,opt,name=Transition,proto3" json:"Transition,omitempty"`
+	Mode                 DemodMode `protobuf:"varint,7,opt,name=Mode,proto3,enum=protocol.DemodMode" json:"Mode,omitempty"`
+	Deviation            float32   `protobuf:"fixed32,8,opt,name=Deviation,proto3" json:"Deviation,omitempty"`
+	FFTSize              uint32    `protobuf:"varint,9,opt,name=FFTSize,proto3" json:"FFTSize,omitempty"`
+	Threshold            float32   `protobuf:"fixed32,10,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	Rate                 float64   `protobuf:"fixed64,11,opt,name=Rate,proto3" json:"Rate,omitempty"`
+	Hysteresis           float32   `protobuf:"fixed32,12,opt,name=Hysteresis,proto3" json:"Hysteresis,omitempty"`
+	HangTime             float32   `protobuf:"fixed32,13,opt,name=HangTime,proto3" json:"HangTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *BlockConfig) Reset()         { *m = BlockConfig{} }
+func (m *BlockConfig) String() string { return proto.CompactTextString(m) }
+func (*BlockConfig) ProtoMessage()    {}
+func (*BlockConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{14}
+}
+
+func (m *BlockConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockConfig.Unmarshal(m, b)
+}
+func (m *BlockConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockConfig.Marshal(b, m, deterministic)
+}
+func (m *BlockConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockConfig.Merge(m, src)
+}
+func (m *BlockConfig) XXX_Size() int {
+	return xxx_messageInfo_BlockConfig.Size(m)
+}
+func (m *BlockConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockConfig proto.InternalMessageInfo
+
+func (m *BlockConfig) GetType() BlockType {
+	if m != nil {
+		return m.Type
+	}
+	return BlockType_TranslatorBlock
+}
+
+func (m *BlockConfig) GetFrequency() float32 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *BlockConfig) GetDecimation() uint32 {
+	if m != nil {
+		return m.Decimation
+	}
+	return 0
+}
+
+func (m *BlockConfig) GetInterpolation() uint32 {
+	if m != nil {
+		return m.Interpolation
+	}
+	return 0
+}
+
+func (m *BlockConfig) GetBandwidth() float32 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
+func (m *BlockConfig) GetTransition() float32 {
+	if m != nil {
+		return m.Transition
+	}
+	return 0
+}
+
+func (m *BlockConfig) GetMode() DemodMode {
+	if m != nil {
+		return m.Mode
+	}
+	return DemodMode_FMDemod
+}
+
+func (m *BlockConfig) GetDeviation() float32 {
+	if m != nil {
+		return m.Deviation
+	}
+	return 0
+}
+
+func (m *BlockConfig) GetFFTSize() uint32 {
+	if m != nil {
+		return m.FFTSize
+	}
+	return 0
+}
+
+func (m *BlockConfig) GetThreshold() float32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *BlockConfig) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *BlockConfig) GetHysteresis() float32 {
+	if m != nil {
+		return m.Hysteresis
+	}
+	return 0
+}
+
+func (m *BlockConfig) GetHangTime() float32 {
+	if m != nil {
+		return m.HangTime
+	}
+	return 0
+}
+
+type ProcessingChain struct {
+	Session              *Session       `protobuf:"bytes,1,opt,name=Session,proto3" json:"Session,omitempty"`
+	Blocks               []*BlockConfig `protobuf:"bytes,2,rep,name=Blocks,proto3" json:"Blocks,omitempty"`
+	SinkRates            []float32      `protobuf:"fixed32,3,rep,packed,name=SinkRates,proto3" json:"SinkRates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ProcessingChain) Reset()         { *m = ProcessingChain{} }
+func (m *ProcessingChain) String() string { return proto.CompactTextString(m) }
+func (*ProcessingChain) ProtoMessage()    {}
+func (*ProcessingChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{15}
+}
+
+func (m *ProcessingChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessingChain.Unmarshal(m, b)
+}
+func (m *ProcessingChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessingChain.Marshal(b, m, deterministic)
+}
+func (m *ProcessingChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessingChain.Merge(m, src)
+}
+func (m *ProcessingChain) XXX_Size() int {
+	return xxx_messageInfo_ProcessingChain.Size(m)
+}
+func (m *ProcessingChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessingChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessingChain proto.InternalMessageInfo
+
+func (m *ProcessingChain) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+func (m *ProcessingChain) GetBlocks() []*BlockConfig {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *ProcessingChain) GetSinkRates() []float32 {
+	if m != nil {
+		return m.SinkRates
+	}
+	return nil
+}
+
+type RecordingRequest struct {
+	Session              *Session     `protobuf:"bytes,1,opt,name=Session,proto3" json:"Session,omitempty"`
+	Format               SampleFormat `protobuf:"varint,2,opt,name=Format,proto3,enum=protocol.SampleFormat" json:"Format,omitempty"`
+	Description          string       `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RecordingRequest) Reset()         { *m = RecordingRequest{} }
+func (m *RecordingRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordingRequest) ProtoMessage()    {}
+func (*RecordingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{16}
+}
+
+func (m *RecordingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecordingRequest.Unmarshal(m, b)
+}
+func (m *RecordingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecordingRequest.Marshal(b, m, deterministic)
+}
+func (m *RecordingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordingRequest.Merge(m, src)
+}
+func (m *RecordingRequest) XXX_Size() int {
+	return xxx_messageInfo_RecordingRequest.Size(m)
+}
+func (m *RecordingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordingRequest proto.InternalMessageInfo
+
+func (m *RecordingRequest) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+func (m *RecordingRequest) GetFormat() SampleFormat {
+	if m != nil {
+		return m.Format
+	}
+	return SampleFormat_CF32
+}
+
+func (m *RecordingRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type Recording struct {
+	ID                   string       `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Format               SampleFormat `protobuf:"varint,2,opt,name=Format,proto3,enum=protocol.SampleFormat" json:"Format,omitempty"`
+	Samples              uint64       `protobuf:"varint,3,opt,name=Samples,proto3" json:"Samples,omitempty"`
+	StartTime            uint64       `protobuf:"varint,4,opt,name=StartTime,proto3" json:"StartTime,omitempty"`
+	StopTime             uint64       `protobuf:"varint,5,opt,name=StopTime,proto3" json:"StopTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Recording) Reset()         { *m = Recording{} }
+func (m *Recording) String() string { return proto.CompactTextString(m) }
+func (*Recording) ProtoMessage()    {}
+func (*Recording) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{17}
+}
+
+func (m *Recording) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Recording.Unmarshal(m, b)
+}
+func (m *Recording) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Recording.Marshal(b, m, deterministic)
+}
+func (m *Recording) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recording.Merge(m, src)
+}
+func (m *Recording) XXX_Size() int {
+	return xxx_messageInfo_Recording.Size(m)
+}
+func (m *Recording) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recording.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Recording proto.InternalMessageInfo
+
+func (m *Recording) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Recording) GetFormat() SampleFormat {
+	if m != nil {
+		return m.Format
+	}
+	return SampleFormat_CF32
+}
+
+func (m *Recording) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+func (m *Recording) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *Recording) GetStopTime() uint64 {
+	if m != nil {
+		return m.StopTime
+	}
+	return 0
+}
+
+type RecordingInfo struct {
+	Recording            *Recording `protobuf:"bytes,1,opt,name=Recording,proto3" json:"Recording,omitempty"`
+	Size                 uint64     `protobuf:"varint,2,opt,name=Size,proto3" json:"Size,omitempty"`
+	Metadata             string     `protobuf:"bytes,3,opt,name=Metadata,proto3" json:"Metadata,omitempty"`
+	Active               bool       `protobuf:"varint,4,opt,name=Active,proto3" json:"Active,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *RecordingInfo) Reset()         { *m = RecordingInfo{} }
+func (m *RecordingInfo) String() string { return proto.CompactTextString(m) }
+func (*RecordingInfo) ProtoMessage()    {}
+func (*RecordingInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{18}
+}
+
+func (m *RecordingInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecordingInfo.Unmarshal(m, b)
+}
+func (m *RecordingInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecordingInfo.Marshal(b, m, deterministic)
+}
+func (m *RecordingInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordingInfo.Merge(m, src)
+}
+func (m *RecordingInfo) XXX_Size() int {
+	return xxx_messageInfo_RecordingInfo.Size(m)
+}
+func (m *RecordingInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordingInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordingInfo proto.InternalMessageInfo
+
+func (m *RecordingInfo) GetRecording() *Recording {
+	if m != nil {
+		return m.Recording
+	}
+	return nil
+}
+
+func (m *RecordingInfo) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *RecordingInfo) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *RecordingInfo) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+type RecordingList struct {
+	Recordings           []*RecordingInfo `protobuf:"bytes,1,rep,name=Recordings,proto3" json:"Recordings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RecordingList) Reset()         { *m = RecordingList{} }
+func (m *RecordingList) String() string { return proto.CompactTextString(m) }
+func (*RecordingList) ProtoMessage()    {}
+func (*RecordingList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{19}
+}
+
+func (m *RecordingList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecordingList.Unmarshal(m, b)
+}
+func (m *RecordingList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecordingList.Marshal(b, m, deterministic)
+}
+func (m *RecordingList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordingList.Merge(m, src)
+}
+func (m *RecordingList) XXX_Size() int {
+	return xxx_messageInfo_RecordingList.Size(m)
+}
+func (m *RecordingList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordingList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordingList proto.InternalMessageInfo
+
+func (m *RecordingList) GetRecordings() []*RecordingInfo {
+	if m != nil {
+		return m.Recordings
+	}
+	return nil
+}
+
+type RecordingSlice struct {
+	ID          string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	StartOffset float64 `protobuf:"fixed64,2,opt,name=StartOffset,proto3" json:"StartOffset,omitempty"`
+	Duration    float64 `protobuf:"fixed64,3,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	// Decimation divides the sample rate, the IQData carry the resulting rate
+	Decimation           uint32   `protobuf:"varint,4,opt,name=Decimation,proto3" json:"Decimation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecordingSlice) Reset()         { *m = RecordingSlice{} }
+func (m *RecordingSlice) String() string { return proto.CompactTextString(m) }
+func (*RecordingSlice) ProtoMessage()    {}
+func (*RecordingSlice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{20}
+}
+
+func (m *RecordingSlice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecordingSlice.Unmarshal(m, b)
+}
+func (m *RecordingSlice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecordingSlice.Marshal(b, m, deterministic)
+}
+func (m *RecordingSlice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordingSlice.Merge(m, src)
+}
+func (m *RecordingSlice) XXX_Size() int {
+	return xxx_messageInfo_RecordingSlice.Size(m)
+}
+func (m *RecordingSlice) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordingSlice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordingSlice proto.InternalMessageInfo
+
+func (m *RecordingSlice) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *RecordingSlice) GetStartOffset() float64 {
+	if m != nil {
+		return m.StartOffset
+	}
+	return 0
+}
+
+func (m *RecordingSlice) GetDuration() float64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *RecordingSlice) GetDecimation() uint32 {
+	if m != nil {
+		return m.Decimation
+	}
+	return 0
+}
+
+type Job struct {
+	ID                   string       `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Device               *DeviceState `protobuf:"bytes,2,opt,name=Device,proto3" json:"Device,omitempty"`
+	StartTime            uint64       `protobuf:"varint,3,opt,name=StartTime,proto3" json:"StartTime,omitempty"`
+	Daily                bool         `protobuf:"varint,4,opt,name=Daily,proto3" json:"Daily,omitempty"`
+	Duration             float64      `protobuf:"fixed64,5,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	Format               SampleFormat `protobuf:"varint,6,opt,name=Format,proto3,enum=protocol.SampleFormat" json:"Format,omitempty"`
+	Description          string       `protobuf:"bytes,7,opt,name=Description,proto3" json:"Description,omitempty"`
+	LastRecording        string       `protobuf:"bytes,8,opt,name=LastRecording,proto3" json:"LastRecording,omitempty"`
+	LastError            string       `protobuf:"bytes,9,opt,name=LastError,proto3" json:"LastError,omitempty"`
+	Running              bool         `protobuf:"varint,10,opt,name=Running,proto3" json:"Running,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Job) Reset()         { *m = Job{} }
+func (m *Job) String() string { return proto.CompactTextString(m) }
+func (*Job) ProtoMessage()    {}
+func (*Job) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{21}
+}
+
+func (m *Job) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Job.Unmarshal(m, b)
+}
+func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Job.Marshal(b, m, deterministic)
+}
+func (m *Job) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Job.Merge(m, src)
+}
+func (m *Job) XXX_Size() int {
+	return xxx_messageInfo_Job.Size(m)
+}
+func (m *Job) XXX_DiscardUnknown() {
+	xxx_messageInfo_Job.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Job proto.InternalMessageInfo
+
+func (m *Job) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Job) GetDevice() *DeviceState {
+	if m != nil {
+		return m.Device
+	}
+	return nil
+}
+
+func (m *Job) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *Job) GetDaily() bool {
+	if m != nil {
+		return m.Daily
+	}
+	return false
+}
+
+func (m *Job) GetDuration() float64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *Job) GetFormat() SampleFormat {
+	if m != nil {
+		return m.Format
+	}
+	return SampleFormat_CF32
+}
+
+func (m *Job) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Job) GetLastRecording() string {
+	if m != nil {
+		return m.LastRecording
+	}
+	return ""
+}
+
+func (m *Job) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *Job) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
+
+type JobList struct {
+	Jobs                 []*Job   `protobuf:"bytes,1,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobList) Reset()         { *m = JobList{} }
+func (m *JobList) String() string { return proto.CompactTextString(m) }
+func (*JobList) ProtoMessage()    {}
+func (*JobList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{22}
+}
+
+func (m *JobList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobList.Unmarshal(m, b)
+}
+func (m *JobList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobList.Marshal(b, m, deterministic)
+}
+func (m *JobList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobList.Merge(m, src)
+}
+func (m *JobList) XXX_Size() int {
+	return xxx_messageInfo_JobList.Size(m)
+}
+func (m *JobList) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobList proto.InternalMessageInfo
+
+func (m *JobList) GetJobs() []*Job {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+type FrequencyRange struct {
+	Start                float64  `protobuf:"fixed64,1,opt,name=Start,proto3" json:"Start,omitempty"`
+	Stop                 float64  `protobuf:"fixed64,2,opt,name=Stop,proto3" json:"Stop,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FrequencyRange) Reset()         { *m = FrequencyRange{} }
+func (m *FrequencyRange) String() string { return proto.CompactTextString(m) }
+func (*FrequencyRange) ProtoMessage()    {}
+func (*FrequencyRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{23}
+}
+
+func (m *FrequencyRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FrequencyRange.Unmarshal(m, b)
+}
+func (m *FrequencyRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FrequencyRange.Marshal(b, m, deterministic)
+}
+func (m *FrequencyRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrequencyRange.Merge(m, src)
+}
+func (m *FrequencyRange) XXX_Size() int {
+	return xxx_messageInfo_FrequencyRange.Size(m)
+}
+func (m *FrequencyRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrequencyRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrequencyRange proto.InternalMessageInfo
+
+func (m *FrequencyRange) GetStart() float64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *FrequencyRange) GetStop() float64 {
+	if m != nil {
+		return m.Stop
+	}
+	return 0
+}
+
+type ScanRequest struct {
+	Session              *Session          `protobuf:"bytes,1,opt,name=Session,proto3" json:"Session,omitempty"`
+	Ranges               []*FrequencyRange `protobuf:"bytes,2,rep,name=Ranges,proto3" json:"Ranges,omitempty"`
+	Threshold            float32           `protobuf:"fixed32,3,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	FFTSize              uint32            `protobuf:"varint,4,opt,name=FFTSize,proto3" json:"FFTSize,omitempty"`
+	Averages             uint32            `protobuf:"varint,5,opt,name=Averages,proto3" json:"Averages,omitempty"`
+	SettleTime           float64           `protobuf:"fixed64,6,opt,name=SettleTime,proto3" json:"SettleTime,omitempty"`
+	Continuous           bool              `protobuf:"varint,7,opt,name=Continuous,proto3" json:"Continuous,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ScanRequest) Reset()         { *m = ScanRequest{} }
+func (m *ScanRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanRequest) ProtoMessage()    {}
+func (*ScanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{24}
+}
+
+func (m *ScanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRequest.Unmarshal(m, b)
+}
+func (m *ScanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRequest.Merge(m, src)
+}
+func (m *ScanRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanRequest.Size(m)
+}
+func (m *ScanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRequest proto.InternalMessageInfo
+
+func (m *ScanRequest) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+func (m *ScanRequest) GetRanges() []*FrequencyRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+func (m *ScanRequest) GetThreshold() float32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ScanRequest) GetFFTSize() uint32 {
+	if m != nil {
+		return m.FFTSize
+	}
+	return 0
+}
+
+func (m *ScanRequest) GetAverages() uint32 {
+	if m != nil {
+		return m.Averages
+	}
+	return 0
+}
+
+func (m *ScanRequest) GetSettleTime() float64 {
+	if m != nil {
+		return m.SettleTime
+	}
+	return 0
+}
+
+func (m *ScanRequest) GetContinuous() bool {
+	if m != nil {
+		return m.Continuous
+	}
+	return false
+}
+
+type ScanPeak struct {
+	Frequency            float64  `protobuf:"fixed64,1,opt,name=Frequency,proto3" json:"Frequency,omitempty"`
+	Power                float32  `protobuf:"fixed32,2,opt,name=Power,proto3" json:"Power,omitempty"`
+	Bandwidth            float64  `protobuf:"fixed64,3,opt,name=Bandwidth,proto3" json:"Bandwidth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScanPeak) Reset()         { *m = ScanPeak{} }
+func (m *ScanPeak) String() string { return proto.CompactTextString(m) }
+func (*ScanPeak) ProtoMessage()    {}
+func (*ScanPeak) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{25}
+}
+
+func (m *ScanPeak) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPeak.Unmarshal(m, b)
+}
+func (m *ScanPeak) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPeak.Marshal(b, m, deterministic)
+}
+func (m *ScanPeak) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPeak.Merge(m, src)
+}
+func (m *ScanPeak) XXX_Size() int {
+	return xxx_messageInfo_ScanPeak.Size(m)
+}
+func (m *ScanPeak) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPeak.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPeak proto.InternalMessageInfo
+
+func (m *ScanPeak) GetFrequency() float64 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *ScanPeak) GetPower() float32 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *ScanPeak) GetBandwidth() float64 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
+type ScanResult struct {
+	Timestamp            uint64      `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	CenterFrequency      float64     `protobuf:"fixed64,2,opt,name=CenterFrequency,proto3" json:"CenterFrequency,omitempty"`
+	StartFrequency       float64     `protobuf:"fixed64,3,opt,name=StartFrequency,proto3" json:"StartFrequency,omitempty"`
+	StopFrequency        float64     `protobuf:"fixed64,4,opt,name=StopFrequency,proto3" json:"StopFrequency,omitempty"`
+	Peaks                []*ScanPeak `protobuf:"bytes,5,rep,name=Peaks,proto3" json:"Peaks,omitempty"`
+	Activity             float32     `protobuf:"fixed32,6,opt,name=Activity,proto3" json:"Activity,omitempty"`
+	NoiseFloor           float32     `protobuf:"fixed32,7,opt,name=NoiseFloor,proto3" json:"NoiseFloor,omitempty"`
+	Pass                 uint32      `protobuf:"varint,8,opt,name=Pass,proto3" json:"Pass,omitempty"`
+	PassDone             bool        `protobuf:"varint,9,opt,name=PassDone,proto3" json:"PassDone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ScanResult) Reset()         { *m = ScanResult{} }
+func (m *ScanResult) String() string { return proto.CompactTextString(m) }
+func (*ScanResult) ProtoMessage()    {}
+func (*ScanResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{26}
+}
+
+func (m *ScanResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanResult.Unmarshal(m, b)
+}
+func (m *ScanResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanResult.Marshal(b, m, deterministic)
+}
+func (m *ScanResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanResult.Merge(m, src)
+}
+func (m *ScanResult) XXX_Size() int {
+	return xxx_messageInfo_ScanResult.Size(m)
+}
+func (m *ScanResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanResult proto.InternalMessageInfo
+
+func (m *ScanResult) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ScanResult) GetCenterFrequency() float64 {
+	if m != nil {
+		return m.CenterFrequency
+	}
+	return 0
+}
+
+func (m *ScanResult) GetStartFrequency() float64 {
+	if m != nil {
+		return m.StartFrequency
+	}
+	return 0
+}
+
+func (m *ScanResult) GetStopFrequency() float64 {
+	if m != nil {
+		return m.StopFrequency
+	}
+	return 0
+}
+
+func (m *ScanResult) GetPeaks() []*ScanPeak {
+	if m != nil {
+		return m.Peaks
+	}
+	return nil
+}
+
+func (m *ScanResult) GetActivity() float32 {
+	if m != nil {
+		return m.Activity
+	}
+	return 0
+}
+
+func (m *ScanResult) GetNoiseFloor() float32 {
+	if m != nil {
+		return m.NoiseFloor
+	}
+	return 0
+}
+
+func (m *ScanResult) GetPass() uint32 {
+	if m != nil {
+		return m.Pass
+	}
+	return 0
+}
+
+func (m *ScanResult) GetPassDone() bool {
+	if m != nil {
+		return m.PassDone
+	}
+	return false
+}
+
+type SweepRequest struct {
+	Session              *Session `protobuf:"bytes,1,opt,name=Session,proto3" json:"Session,omitempty"`
+	StartFrequency       float64  `protobuf:"fixed64,2,opt,name=StartFrequency,proto3" json:"StartFrequency,omitempty"`
+	StopFrequency        float64  `protobuf:"fixed64,3,opt,name=StopFrequency,proto3" json:"StopFrequency,omitempty"`
+	BinWidth             float64  `protobuf:"fixed64,4,opt,name=BinWidth,proto3" json:"BinWidth,omitempty"`
+	Averages             uint32   `protobuf:"varint,5,opt,name=Averages,proto3" json:"Averages,omitempty"`
+	SettleTime           float64  `protobuf:"fixed64,6,opt,name=SettleTime,proto3" json:"SettleTime,omitempty"`
+	Overlap              float32  `protobuf:"fixed32,7,opt,name=Overlap,proto3" json:"Overlap,omitempty"`
+	CalibrationOffset    float32  `protobuf:"fixed32,8,opt,name=CalibrationOffset,proto3" json:"CalibrationOffset,omitempty"`
+	Sweeps               uint32   `protobuf:"varint,9,opt,name=Sweeps,proto3" json:"Sweeps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SweepRequest) Reset()         { *m = SweepRequest{} }
+func (m *SweepRequest) String() string { return proto.CompactTextString(m) }
+func (*SweepRequest) ProtoMessage()    {}
+func (*SweepRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{27}
+}
+
+func (m *SweepRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SweepRequest.Unmarshal(m, b)
+}
+func (m *SweepRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SweepRequest.Marshal(b, m, deterministic)
+}
+func (m *SweepRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepRequest.Merge(m, src)
+}
+func (m *SweepRequest) XXX_Size() int {
+	return xxx_messageInfo_SweepRequest.Size(m)
+}
+func (m *SweepRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweepRequest proto.InternalMessageInfo
+
+func (m *SweepRequest) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+func (m *SweepRequest) GetStartFrequency() float64 {
+	if m != nil {
+		return m.StartFrequency
+	}
+	return 0
+}
+
+func (m *SweepRequest) GetStopFrequency() float64 {
+	if m != nil {
+		return m.StopFrequency
+	}
+	return 0
+}
+
+func (m *SweepRequest) GetBinWidth() float64 {
+	if m != nil {
+		return m.BinWidth
+	}
+	return 0
+}
+
+func (m *SweepRequest) GetAverages() uint32 {
+	if m != nil {
+		return m.Averages
+	}
+	return 0
+}
+
+func (m *SweepRequest) GetSettleTime() float64 {
+	if m != nil {
+		return m.SettleTime
+	}
+	return 0
+}
+
+func (m *SweepRequest) GetOverlap() float32 {
+	if m != nil {
+		return m.Overlap
+	}
+	return 0
+}
+
+func (m *SweepRequest) GetCalibrationOffset() float32 {
+	if m != nil {
+		return m.CalibrationOffset
+	}
+	return 0
+}
+
+func (m *SweepRequest) GetSweeps() uint32 {
+	if m != nil {
+		return m.Sweeps
+	}
+	return 0
+}
+
+type SweepFrame struct {
+	Timestamp            uint64    `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Duration             uint64    `protobuf:"varint,2,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	StartFrequency       float64   `protobuf:"fixed64,3,opt,name=StartFrequency,proto3" json:"StartFrequency,omitempty"`
+	BinWidth             float64   `protobuf:"fixed64,4,opt,name=BinWidth,proto3" json:"BinWidth,omitempty"`
+	Power                []float32 `protobuf:"fixed32,5,rep,packed,name=Power,proto3" json:"Power,omitempty"`
+	Sweep                uint32    `protobuf:"varint,6,opt,name=Sweep,proto3" json:"Sweep,omitempty"`
+	Hops                 uint32    `protobuf:"varint,7,opt,name=Hops,proto3" json:"Hops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SweepFrame) Reset()         { *m = SweepFrame{} }
+func (m *SweepFrame) String() string { return proto.CompactTextString(m) }
+func (*SweepFrame) ProtoMessage()    {}
+func (*SweepFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{28}
+}
+
+func (m *SweepFrame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SweepFrame.Unmarshal(m, b)
+}
+func (m *SweepFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SweepFrame.Marshal(b, m, deterministic)
+}
+func (m *SweepFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepFrame.Merge(m, src)
+}
+func (m *SweepFrame) XXX_Size() int {
+	return xxx_messageInfo_SweepFrame.Size(m)
+}
+func (m *SweepFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweepFrame proto.InternalMessageInfo
+
+func (m *SweepFrame) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *SweepFrame) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *SweepFrame) GetStartFrequency() float64 {
+	if m != nil {
+		return m.StartFrequency
+	}
+	return 0
+}
+
+func (m *SweepFrame) GetBinWidth() float64 {
+	if m != nil {
+		return m.BinWidth
+	}
+	return 0
+}
+
+func (m *SweepFrame) GetPower() []float32 {
+	if m != nil {
+		return m.Power
+	}
+	return nil
+}
+
+func (m *SweepFrame) GetSweep() uint32 {
+	if m != nil {
+		return m.Sweep
+	}
+	return 0
+}
+
+func (m *SweepFrame) GetHops() uint32 {
+	if m != nil {
+		return m.Hops
+	}
+	return 0
+}
+
+type IQData struct {
+	Timestamp       uint64        `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Status          StatusType    `protobuf:"varint,2,opt,name=status,proto3,enum=protocol.StatusType" json:"status,omitempty"`
+	Samples         []float32     `protobuf:"fixed32,4,rep,packed,name=Samples,proto3" json:"Samples,omitempty"`
+	Error           string        `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	SampleIndex     uint64        `protobuf:"varint,5,opt,name=SampleIndex,proto3" json:"SampleIndex,omitempty"`
+	ServerDropped   uint64        `protobuf:"varint,6,opt,name=ServerDropped,proto3" json:"ServerDropped,omitempty"`
+	FrontendDropped uint64        `protobuf:"varint,7,opt,name=FrontendDropped,proto3" json:"FrontendDropped,omitempty"`
+	HardwareIndex   uint64        `protobuf:"varint,8,opt,name=HardwareIndex,proto3" json:"HardwareIndex,omitempty"`
+	Kind            SampleKind    `protobuf:"varint,9,opt,name=Kind,proto3,enum=protocol.SampleKind" json:"Kind,omitempty"`
+	Sink            uint32        `protobuf:"varint,10,opt,name=Sink,proto3" json:"Sink,omitempty"`
+	SampleRate      float32       `protobuf:"fixed32,11,opt,name=SampleRate,proto3" json:"SampleRate,omitempty"`
+	DigitalGain     float32       `protobuf:"fixed32,12,opt,name=DigitalGain,proto3" json:"DigitalGain,omitempty"`
+	Squelch         *SquelchEvent `protobuf:"bytes,13,opt,name=Squelch,proto3" json:"Squelch,omitempty"`
+	// Squelched is the number of samples held back by a squelch since the previous buffer of the sink
+	Squelched            uint64   `protobuf:"varint,14,opt,name=Squelched,proto3" json:"Squelched,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IQData) Reset()         { *m = IQData{} }
+func (m *IQData) String() string { return proto.CompactTextString(m) }
+func (*IQData) ProtoMessage()    {}
+func (*IQData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{29}
+}
+
+func (m *IQData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IQData.Unmarshal(m, b)
+}
+func (m *IQData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IQData.Marshal(b, m, deterministic)
+}
+func (m *IQData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IQData.Merge(m, src)
+}
+func (m *IQData) XXX_Size() int {
+	return xxx_messageInfo_IQData.Size(m)
+}
+func (m *IQData) XXX_DiscardUnknown() {
+	xxx_messageInfo_IQData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IQData proto.InternalMessageInfo
+
+func (m *IQData) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *IQData) GetStatus() StatusType {
+	if m != nil {
+		return m.Status
+	}
+	return StatusType_Invalid
+}
+
+func (m *IQData) GetSamples() []float32 {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+func (m *IQData) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *IQData) GetSampleIndex() uint64 {
+	if m != nil {
+		return m.SampleIndex
+	}
+	return 0
+}
+
+func (m *IQData) GetServerDropped() uint64 {
+	if m != nil {
+		return m.ServerDropped
+	}
+	return 0
+}
+
+func (m *IQData) GetFrontendDropped() uint64 {
+	if m != nil {
+		return m.FrontendDropped
+	}
+	return 0
+}
+
+func (m *IQData) GetHardwareIndex() uint64 {
+	if m != nil {
+		return m.HardwareIndex
+	}
+	return 0
+}
+
+func (m *IQData) GetKind() SampleKind {
+	if m != nil {
+		return m.Kind
+	}
+	return SampleKind_IQSamples
+}
+
+func (m *IQData) GetSink() uint32 {
+	if m != nil {
+		return m.Sink
+	}
+	return 0
+}
+
+func (m *IQData) GetSampleRate() float32 {
+	if m != nil {
+		return m.SampleRate
+	}
+	return 0
+}
+
+func (m *IQData) GetDigitalGain() float32 {
+	if m != nil {
+		return m.DigitalGain
+	}
+	return 0
+}
+
+func (m *IQData) GetSquelch() *SquelchEvent {
+	if m != nil {
+		return m.Squelch
+	}
+	return nil
+}
+
+func (m *IQData) GetSquelched() uint64 {
+	if m != nil {
+		return m.Squelched
+	}
+	return 0
+}
+
+type SquelchEvent struct {
+	Timestamp            uint64   `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	HardwareIndex        uint64   `protobuf:"varint,2,opt,name=HardwareIndex,proto3" json:"HardwareIndex,omitempty"`
+	Block                uint32   `protobuf:"varint,3,opt,name=Block,proto3" json:"Block,omitempty"`
+	Open                 bool     `protobuf:"varint,4,opt,name=Open,proto3" json:"Open,omitempty"`
+	Changed              bool     `protobuf:"varint,5,opt,name=Changed,proto3" json:"Changed,omitempty"`
+	Power                float32  `protobuf:"fixed32,6,opt,name=Power,proto3" json:"Power,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SquelchEvent) Reset()         { *m = SquelchEvent{} }
+func (m *SquelchEvent) String() string { return proto.CompactTextString(m) }
+func (*SquelchEvent) ProtoMessage()    {}
+func (*SquelchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{30}
+}
+
+func (m *SquelchEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SquelchEvent.Unmarshal(m, b)
+}
+func (m *SquelchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SquelchEvent.Marshal(b, m, deterministic)
+}
+func (m *SquelchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SquelchEvent.Merge(m, src)
+}
+func (m *SquelchEvent) XXX_Size() int {
+	return xxx_messageInfo_SquelchEvent.Size(m)
+}
+func (m *SquelchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SquelchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SquelchEvent proto.InternalMessageInfo
+
+func (m *SquelchEvent) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *SquelchEvent) GetHardwareIndex() uint64 {
+	if m != nil {
+		return m.HardwareIndex
+	}
+	return 0
+}
+
+func (m *SquelchEvent) GetBlock() uint32 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *SquelchEvent) GetOpen() bool {
+	if m != nil {
+		return m.Open
+	}
+	return false
+}
+
+func (m *SquelchEvent) GetChanged() bool {
+	if m != nil {
+		return m.Changed
+	}
+	return false
+}
+
+func (m *SquelchEvent) GetPower() float32 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+type StreamCommand struct {
+	ID                   uint64         `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Type                 CommandType    `protobuf:"varint,2,opt,name=Type,proto3,enum=protocol.CommandType" json:"Type,omitempty"`
+	Session              *Session       `protobuf:"bytes,3,opt,name=Session,proto3" json:"Session,omitempty"`
+	Config               *DeviceConfig  `protobuf:"bytes,4,opt,name=Config,proto3" json:"Config,omitempty"`
+	Chain                []*BlockConfig `protobuf:"bytes,5,rep,name=Chain,proto3" json:"Chain,omitempty"`
+	Device               *DeviceState   `protobuf:"bytes,6,opt,name=Device,proto3" json:"Device,omitempty"`
+	ChannelIndex         uint32         `protobuf:"varint,7,opt,name=ChannelIndex,proto3" json:"ChannelIndex,omitempty"`
+	Gain                 float32        `protobuf:"fixed32,8,opt,name=Gain,proto3" json:"Gain,omitempty"`
+	ChannelConfig        *ChannelConfig `protobuf:"bytes,9,opt,name=ChannelConfig,proto3" json:"ChannelConfig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StreamCommand) Reset()         { *m = StreamCommand{} }
+func (m *StreamCommand) String() string { return proto.CompactTextString(m) }
+func (*StreamCommand) ProtoMessage()    {}
+func (*StreamCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{31}
+}
+
+func (m *StreamCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamCommand.Unmarshal(m, b)
+}
+func (m *StreamCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamCommand.Marshal(b, m, deterministic)
+}
+func (m *StreamCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamCommand.Merge(m, src)
+}
+func (m *StreamCommand) XXX_Size() int {
+	return xxx_messageInfo_StreamCommand.Size(m)
+}
+func (m *StreamCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamCommand proto.InternalMessageInfo
+
+func (m *StreamCommand) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *StreamCommand) GetType() CommandType {
+	if m != nil {
+		return m.Type
+	}
+	return CommandType_NoCommand
+}
+
+func (m *StreamCommand) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+func (m *StreamCommand) GetConfig() *DeviceConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *StreamCommand) GetChain() []*BlockConfig {
+	if m != nil {
+		return m.Chain
+	}
+	return nil
+}
+
+func (m *StreamCommand) GetDevice() *DeviceState {
+	if m != nil {
+		return m.Device
+	}
+	return nil
+}
+
+func (m *StreamCommand) GetChannelIndex() uint32 {
+	if m != nil {
+		return m.ChannelIndex
+	}
+	return 0
+}
+
+func (m *StreamCommand) GetGain() float32 {
+	if m != nil {
+		return m.Gain
+	}
+	return 0
+}
+
+func (m *StreamCommand) GetChannelConfig() *ChannelConfig {
+	if m != nil {
+		return m.ChannelConfig
+	}
+	return nil
+}
+
+type StreamAck struct {
+	ID     uint64        `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Type   CommandType   `protobuf:"varint,2,opt,name=Type,proto3,enum=protocol.CommandType" json:"Type,omitempty"`
+	Status StatusType    `protobuf:"varint,3,opt,name=Status,proto3,enum=protocol.StatusType" json:"Status,omitempty"`
+	Error  string        `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+	Config *DeviceConfig `protobuf:"bytes,5,opt,name=Config,proto3" json:"Config,omitempty"`
+	// SampleIndex is the index in the first sink of the first sample acquired after the command,
+	// HardwareIndex its device sample counter
+	SampleIndex          uint64    `protobuf:"varint,6,opt,name=SampleIndex,proto3" json:"SampleIndex,omitempty"`
+	SinkRates            []float32 `protobuf:"fixed32,7,rep,packed,name=SinkRates,proto3" json:"SinkRates,omitempty"`
+	Session              *Session  `protobuf:"bytes,8,opt,name=Session,proto3" json:"Session,omitempty"`
+	HardwareIndex        uint64    `protobuf:"varint,9,opt,name=HardwareIndex,proto3" json:"HardwareIndex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *StreamAck) Reset()         { *m = StreamAck{} }
+func (m *StreamAck) String() string { return proto.CompactTextString(m) }
+func (*StreamAck) ProtoMessage()    {}
+func (*StreamAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{32}
+}
+
+func (m *StreamAck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamAck.Unmarshal(m, b)
+}
+func (m *StreamAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamAck.Marshal(b, m, deterministic)
+}
+func (m *StreamAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamAck.Merge(m, src)
+}
+func (m *StreamAck) XXX_Size() int {
+	return xxx_messageInfo_StreamAck.Size(m)
+}
+func (m *StreamAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamAck proto.InternalMessageInfo
+
+func (m *StreamAck) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *StreamAck) GetType() CommandType {
+	if m != nil {
+		return m.Type
+	}
+	return CommandType_NoCommand
+}
+
+func (m *StreamAck) GetStatus() StatusType {
+	if m != nil {
+		return m.Status
+	}
+	return StatusType_Invalid
+}
+
+func (m *StreamAck) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *StreamAck) GetConfig() *DeviceConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *StreamAck) GetSampleIndex() uint64 {
+	if m != nil {
+		return m.SampleIndex
+	}
+	return 0
+}
+
+func (m *StreamAck) GetSinkRates() []float32 {
+	if m != nil {
+		return m.SinkRates
+	}
+	return nil
+}
+
+func (m *StreamAck) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+func (m *StreamAck) GetHardwareIndex() uint64 {
+	if m != nil {
+		return m.HardwareIndex
+	}
+	return 0
+}
+
+type StreamStatus struct {
+	Timestamp            uint64     `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Status               StatusType `protobuf:"varint,2,opt,name=Status,proto3,enum=protocol.StatusType" json:"Status,omitempty"`
+	Message              string     `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *StreamStatus) Reset()         { *m = StreamStatus{} }
+func (m *StreamStatus) String() string { return proto.CompactTextString(m) }
+func (*StreamStatus) ProtoMessage()    {}
+func (*StreamStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{33}
+}
+
+func (m *StreamStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamStatus.Unmarshal(m, b)
+}
+func (m *StreamStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamStatus.Marshal(b, m, deterministic)
+}
+func (m *StreamStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamStatus.Merge(m, src)
+}
+func (m *StreamStatus) XXX_Size() int {
+	return xxx_messageInfo_StreamStatus.Size(m)
+}
+func (m *StreamStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamStatus proto.InternalMessageInfo
+
+func (m *StreamStatus) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *StreamStatus) GetStatus() StatusType {
+	if m != nil {
+		return m.Status
+	}
+	return StatusType_Invalid
+}
+
+func (m *StreamStatus) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type StreamMessage struct {
+	IQ                   *IQData       `protobuf:"bytes,1,opt,name=IQ,proto3" json:"IQ,omitempty"`
+	Ack                  *StreamAck    `protobuf:"bytes,2,opt,name=Ack,proto3" json:"Ack,omitempty"`
+	Status               *StreamStatus `protobuf:"bytes,3,opt,name=Status,proto3" json:"Status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StreamMessage) Reset()         { *m = StreamMessage{} }
+func (m *StreamMessage) String() string { return proto.CompactTextString(m) }
+func (*StreamMessage) ProtoMessage()    {}
+func (*StreamMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{34}
+}
+
+func (m *StreamMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamMessage.Unmarshal(m, b)
+}
+func (m *StreamMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamMessage.Marshal(b, m, deterministic)
+}
+func (m *StreamMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamMessage.Merge(m, src)
+}
+func (m *StreamMessage) XXX_Size() int {
+	return xxx_messageInfo_StreamMessage.Size(m)
+}
+func (m *StreamMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamMessage proto.InternalMessageInfo
+
+func (m *StreamMessage) GetIQ() *IQData {
+	if m != nil {
+		return m.IQ
+	}
+	return nil
+}
+
+func (m *StreamMessage) GetAck() *StreamAck {
+	if m != nil {
+		return m.Ack
+	}
+	return nil
+}
+
+func (m *StreamMessage) GetStatus() *StreamStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type Version struct {
+	Major                uint32   `protobuf:"varint,1,opt,name=Major,proto3" json:"Major,omitempty"`
+	Minor                uint32   `protobuf:"varint,2,opt,name=Minor,proto3" json:"Minor,omitempty"`
+	Hash                 uint32   `protobuf:"varint,3,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Version) Reset()         { *m = Version{} }
+func (m *Version) String() string { return proto.CompactTextString(m) }
+func (*Version) ProtoMessage()    {}
+func (*Version) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{35}
+}
+
+func (m *Version) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Version.Unmarshal(m, b)
+}
+func (m *Version) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Version.Marshal(b, m, deterministic)
+}
+func (m *Version) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Version.Merge(m, src)
+}
+func (m *Version) XXX_Size() int {
+	return xxx_messageInfo_Version.Size(m)
+}
+func (m *Version) XXX_DiscardUnknown() {
+	xxx_messageInfo_Version.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Version proto.InternalMessageInfo
+
+func (m *Version) GetMajor() uint32 {
+	if m != nil {
+		return m.Major
+	}
+	return 0
+}
+
+func (m *Version) GetMinor() uint32 {
+	if m != nil {
+		return m.Minor
+	}
+	return 0
+}
+
+func (m *Version) GetHash() uint32 {
+	if m != nil {
+		return m.Hash
+	}
+	return 0
+}
+
+type ServerInfoData struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Version              *Version `protobuf:"bytes,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServerInfoData) Reset()         { *m = ServerInfoData{} }
+func (m *ServerInfoData) String() string { return proto.CompactTextString(m) }
+func (*ServerInfoData) ProtoMessage()    {}
+func (*ServerInfoData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{36}
+}
+
+func (m *ServerInfoData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServerInfoData.Unmarshal(m, b)
+}
+func (m *ServerInfoData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServerInfoData.Marshal(b, m, deterministic)
+}
+func (m *ServerInfoData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerInfoData.Merge(m, src)
+}
+func (m *ServerInfoData) XXX_Size() int {
+	return xxx_messageInfo_ServerInfoData.Size(m)
+}
+func (m *ServerInfoData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerInfoData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServerInfoData proto.InternalMessageInfo
+
+func (m *ServerInfoData) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServerInfoData) GetVersion() *Version {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+type Empty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{37}
+}
+
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Empty.Unmarshal(m, b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+}
+func (m *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(m, src)
+}
+func (m *Empty) XXX_Size() int {
+	return xxx_messageInfo_Empty.Size(m)
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("protocol.DeviceName", DeviceName_name, DeviceName_value)
+	proto.RegisterEnum("protocol.StatusType", StatusType_name, StatusType_value)
+	proto.RegisterEnum("protocol.BlockType", BlockType_name, BlockType_value)
+	proto.RegisterEnum("protocol.DemodMode", DemodMode_name, DemodMode_value)
+	proto.RegisterEnum("protocol.SampleKind", SampleKind_name, SampleKind_value)
+	proto.RegisterEnum("protocol.SampleFormat", SampleFormat_name, SampleFormat_value)
+	proto.RegisterEnum("protocol.CommandType", CommandType_name, CommandType_value)
+	proto.RegisterType((*Session)(nil), "protocol.Session")
+	proto.RegisterType((*DeviceInfo)(nil), "protocol.DeviceInfo")
+	proto.RegisterType((*DeviceList)(nil), "protocol.DeviceList")
+	proto.RegisterType((*DeviceConfig)(nil), "protocol.DeviceConfig")
+	proto.RegisterType((*DeviceState)(nil), "protocol.DeviceState")
+	proto.RegisterType((*DeviceTune)(nil), "protocol.DeviceTune")
+	proto.RegisterType((*ChannelConfig)(nil), "protocol.ChannelConfig")
+	proto.RegisterType((*IQCorrectionState)(nil), "protocol.IQCorrectionState")
+	proto.RegisterType((*IQCorrectionList)(nil), "protocol.IQCorrectionList")
+	proto.RegisterType((*CalibrationRequest)(nil), "protocol.CalibrationRequest")
+	proto.RegisterType((*FrequencyCorrection)(nil), "protocol.FrequencyCorrection")
+	proto.RegisterType((*FrequencyCorrectionList)(nil), "protocol.FrequencyCorrectionList")
+	proto.RegisterType((*PPMEstimateRequest)(nil), "protocol.PPMEstimateRequest")
+	proto.RegisterType((*PPMEstimate)(nil), "protocol.PPMEstimate")
+	proto.RegisterType((*BlockConfig)(nil), "protocol.BlockConfig")
+	proto.RegisterType((*ProcessingChain)(nil), "protocol.ProcessingChain")
+	proto.RegisterType((*RecordingRequest)(nil), "protocol.RecordingRequest")
+	proto.RegisterType((*Recording)(nil), "protocol.Recording")
+	proto.RegisterType((*RecordingInfo)(nil), "protocol.RecordingInfo")
+	proto.RegisterType((*RecordingList)(nil), "protocol.RecordingList")
+	proto.RegisterType((*RecordingSlice)(nil), "protocol.RecordingSlice")
+	proto.RegisterType((*Job)(nil), "protocol.Job")
+	proto.RegisterType((*JobList)(nil), "protocol.JobList")
+	proto.RegisterType((*FrequencyRange)(nil), "protocol.FrequencyRange")
+	proto.RegisterType((*ScanRequest)(nil), "protocol.ScanRequest")
+	proto.RegisterType((*ScanPeak)(nil), "protocol.ScanPeak")
+	proto.RegisterType((*ScanResult)(nil), "protocol.ScanResult")
+	proto.RegisterType((*SweepRequest)(nil), "protocol.SweepRequest")
+	proto.RegisterType((*SweepFrame)(nil), "protocol.SweepFrame")
+	proto.RegisterType((*IQData)(nil), "protocol.IQData")
+	proto.RegisterType((*SquelchEvent)(nil), "protocol.SquelchEvent")
+	proto.RegisterType((*StreamCommand)(nil), "protocol.StreamCommand")
+	proto.RegisterType((*StreamAck)(nil), "protocol.StreamAck")
+	proto.RegisterType((*StreamStatus)(nil), "protocol.StreamStatus")
+	proto.RegisterType((*StreamMessage)(nil), "protocol.StreamMessage")
+	proto.RegisterType((*Version)(nil), "protocol.Version")
+	proto.RegisterType((*ServerInfoData)(nil), "protocol.ServerInfoData")
+	proto.RegisterType((*Empty)(nil), "protocol.Empty")
+}
+
+func init() { proto.RegisterFile("server.proto", fileDescriptor_ad098daeda4239f7) }
 
 var fileDescriptor_ad098daeda4239f7 = []byte{
-	// 848 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xe1, 0x72, 0xdb, 0x44,
-	0x10, 0xae, 0x64, 0xc5, 0xae, 0xd7, 0x75, 0xa2, 0x1e, 0x6d, 0xd0, 0x64, 0x18, 0xc8, 0x68, 0x18,
-	0xc6, 0x4d, 0x53, 0x0f, 0x13, 0x0a, 0xfc, 0xe1, 0x4f, 0x62, 0x37, 0xd4, 0x43, 0xd2, 0x26, 0x27,
-	0xc1, 0xf8, 0xef, 0x61, 0x5f, 0x9d, 0xa3, 0xd2, 0x9d, 0x39, 0x9d, 0x0d, 0xe6, 0x37, 0x8f, 0xc2,
-	0x83, 0xf0, 0x14, 0xbc, 0x03, 0x6f, 0xc1, 0xdc, 0x4a, 0x8a, 0x64, 0x3b, 0x19, 0xa6, 0xbf, 0xac,
-	0xfd, 0xf6, 0xbb, 0xdb, 0x6f, 0xf7, 0x76, 0xd7, 0xf0, 0x28, 0xe3, 0x7a, 0xc9, 0x75, 0x7f, 0xae,
-	0x95, 0x51, 0xe4, 0x21, 0xfe, 0x4c, 0x54, 0x12, 0x7e, 0x06, 0xad, 0x88, 0x67, 0x99, 0x50, 0x92,
-	0x3c, 0x81, 0x9d, 0x58, 0xbd, 0xe7, 0x32, 0x70, 0x0e, 0x9d, 0x5e, 0x9b, 0xe6, 0x46, 0xf8, 0x8f,
-	0x0b, 0x30, 0xe4, 0x4b, 0x31, 0xe1, 0x23, 0xf9, 0x4e, 0x91, 0x1e, 0x78, 0x6f, 0x58, 0xca, 0x91,
-	0xb3, 0x7b, 0xf2, 0xa4, 0x5f, 0x5e, 0xd4, 0xcf, 0x39, 0xd6, 0x47, 0x91, 0x41, 0xf6, 0xa1, 0x19,
-	0x71, 0x2d, 0x58, 0x12, 0xb8, 0x78, 0x5f, 0x61, 0x91, 0x63, 0x78, 0x7c, 0xc9, 0x7e, 0x17, 0xe9,
-	0x22, 0x8d, 0x58, 0x3a, 0x4f, 0x38, 0x65, 0x86, 0x07, 0x8d, 0x43, 0xa7, 0xd7, 0xa5, 0xdb, 0x0e,
-	0x72, 0x04, 0xfe, 0xa5, 0x90, 0x16, 0x3c, 0xd7, 0xfc, 0xd7, 0x05, 0x97, 0x93, 0x55, 0xd0, 0x44,
-	0xf2, 0x16, 0x8e, 0xdc, 0xfc, 0x82, 0x8a, 0xdb, 0x2a, 0xb8, 0x1b, 0x38, 0xf9, 0x1c, 0xba, 0xa7,
-	0xc3, 0x01, 0xe5, 0x99, 0x4a, 0x16, 0x46, 0x28, 0x19, 0x3c, 0x44, 0xe2, 0x3a, 0x58, 0xd3, 0x4a,
-	0xc7, 0x83, 0x1b, 0x26, 0x25, 0x4f, 0xb2, 0xa0, 0xbd, 0xa6, 0xb5, 0x72, 0xd4, 0xd8, 0x71, 0xc5,
-	0x86, 0x35, 0x76, 0xe5, 0x08, 0xbf, 0x2b, 0xeb, 0x7a, 0x21, 0x32, 0x43, 0xfa, 0xd0, 0xca, 0xad,
-	0x2c, 0x70, 0x0e, 0x1b, 0xbd, 0xce, 0x76, 0x69, 0x6d, 0xf9, 0x69, 0x49, 0x0a, 0xff, 0x72, 0xe0,
-	0x51, 0xfe, 0x3d, 0x50, 0xf2, 0x9d, 0x98, 0x91, 0x4f, 0x01, 0x6a, 0xf5, 0xb4, 0xcf, 0xe3, 0xd2,
-	0x1a, 0x62, 0xfd, 0x6f, 0x97, 0x5c, 0x67, 0x88, 0xe0, 0x93, 0x74, 0x69, 0x0d, 0x21, 0xcf, 0xa0,
-	0x41, 0xc7, 0x83, 0xa0, 0x81, 0xc1, 0x3f, 0xae, 0x82, 0x17, 0x7a, 0xf3, 0x28, 0xd4, 0x72, 0x2c,
-	0x35, 0x1e, 0x0f, 0x02, 0xef, 0x7f, 0xa8, 0xf1, 0x78, 0x10, 0xce, 0xa0, 0x93, 0xab, 0x8c, 0x8c,
-	0x15, 0xd1, 0x03, 0xcf, 0xa6, 0x81, 0xf2, 0xee, 0x4b, 0x11, 0x19, 0xa4, 0x0f, 0xcd, 0xfc, 0x1e,
-	0x94, 0xda, 0x39, 0xd9, 0xdf, 0xe4, 0x16, 0x51, 0x0a, 0x56, 0x28, 0xca, 0x6a, 0xc6, 0x0b, 0xc9,
-	0xc9, 0xf3, 0xdb, 0xae, 0x2e, 0x42, 0x3d, 0xae, 0x8e, 0x17, 0x0e, 0x7a, 0xdb, 0xf7, 0x1f, 0x1a,
-	0xea, 0x5f, 0x07, 0xba, 0x6b, 0xa9, 0x92, 0x1e, 0xec, 0x0d, 0xb8, 0x34, 0x5c, 0x57, 0x7d, 0x97,
-	0x3f, 0xc0, 0x26, 0x4c, 0xbe, 0x80, 0xdd, 0x37, 0x4a, 0xa7, 0x2c, 0x11, 0x7f, 0xf0, 0xe9, 0xf7,
-	0x4c, 0x48, 0x8c, 0xe9, 0xd2, 0x0d, 0x94, 0xbc, 0x84, 0xa7, 0xa7, 0x92, 0x25, 0x6a, 0x76, 0x2e,
-	0x12, 0xc3, 0xf5, 0x19, 0x93, 0xd3, 0xdf, 0xc4, 0xd4, 0xdc, 0xe0, 0xa0, 0xb8, 0xf4, 0x6e, 0x27,
-	0xf9, 0x06, 0xf6, 0x87, 0x62, 0x26, 0x0c, 0x4b, 0x36, 0x8f, 0x79, 0x78, 0xec, 0x1e, 0x2f, 0x09,
-	0xa0, 0x75, 0x2a, 0x0d, 0x97, 0x92, 0x05, 0x3b, 0x38, 0xab, 0xa5, 0x19, 0xfe, 0xe9, 0x40, 0x73,
-	0x74, 0x3d, 0x64, 0x86, 0x91, 0x4f, 0xa0, 0x1d, 0x8b, 0x94, 0x67, 0x86, 0xa5, 0x73, 0x4c, 0xcf,
-	0xa3, 0x15, 0x40, 0x8e, 0xa1, 0x99, 0x19, 0x66, 0x16, 0x19, 0x26, 0xb4, 0xb6, 0x19, 0x22, 0xc4,
-	0xe3, 0xd5, 0x9c, 0xd3, 0x82, 0x63, 0x03, 0xe6, 0xad, 0x99, 0x61, 0x17, 0xb9, 0xb4, 0x34, 0xed,
-	0x12, 0x7a, 0xa5, 0xb5, 0xd2, 0x98, 0x68, 0x9b, 0xe6, 0x46, 0x38, 0x82, 0xd6, 0x4f, 0x5c, 0x97,
-	0x5b, 0xea, 0x92, 0xfd, 0xa2, 0x34, 0x4a, 0xe8, 0xd2, 0xdc, 0x40, 0x54, 0x48, 0xa5, 0x8b, 0xc6,
-	0xce, 0x0d, 0x42, 0xc0, 0x7b, 0xcd, 0xb2, 0x9b, 0x62, 0xbb, 0xe0, 0x77, 0x78, 0x0d, 0xbb, 0x11,
-	0xae, 0x42, 0xdb, 0x66, 0x98, 0x18, 0xa9, 0xad, 0xb4, 0x76, 0xb1, 0xbc, 0x9e, 0xdf, 0x06, 0x2c,
-	0x9a, 0xa2, 0xd6, 0x40, 0x85, 0x83, 0x96, 0x8c, 0xb0, 0x05, 0x3b, 0xaf, 0xd2, 0xb9, 0x59, 0x1d,
-	0xf1, 0xb2, 0x09, 0xf1, 0x8e, 0x5d, 0x80, 0x98, 0x67, 0x26, 0x12, 0x33, 0xc9, 0x12, 0xff, 0x81,
-	0xb5, 0x4f, 0x85, 0xce, 0xe6, 0x2b, 0xbb, 0xb8, 0x7c, 0x87, 0x00, 0x34, 0x69, 0x7c, 0x11, 0x0d,
-	0xa9, 0xef, 0x92, 0x3d, 0xe8, 0x5c, 0x88, 0x94, 0x47, 0x43, 0x8a, 0xce, 0x86, 0x25, 0x17, 0xc0,
-	0x8f, 0xd1, 0x99, 0xef, 0x59, 0xf2, 0x6b, 0x36, 0x79, 0x4f, 0xcf, 0xfd, 0x9d, 0xa3, 0x63, 0x80,
-	0xaa, 0xa6, 0xa4, 0x03, 0xad, 0x91, 0x5c, 0xb2, 0x44, 0x4c, 0xfd, 0x07, 0xa4, 0x09, 0xee, 0xdb,
-	0x1f, 0x7c, 0x87, 0xb4, 0x8b, 0x32, 0xfa, 0xee, 0xc9, 0xdf, 0x2e, 0x74, 0x28, 0x9b, 0x0a, 0x95,
-	0xa7, 0x4d, 0x5e, 0x80, 0x87, 0x1b, 0x67, 0xaf, 0xca, 0x08, 0xd5, 0x1f, 0x6c, 0x8d, 0x23, 0xd2,
-	0xbe, 0x86, 0xf6, 0x95, 0x56, 0x4b, 0x81, 0xc5, 0x7f, 0xba, 0x49, 0xc1, 0xb1, 0x3e, 0xd8, 0x9e,
-	0x2e, 0xf2, 0xc2, 0xee, 0xb3, 0xcc, 0x68, 0xb5, 0x22, 0xdb, 0xde, 0x83, 0xcd, 0xd8, 0xe4, 0x5b,
-	0x80, 0xea, 0x55, 0xb6, 0xa5, 0x05, 0xf5, 0x2b, 0xd6, 0x1e, 0xef, 0x25, 0x78, 0x38, 0xf1, 0x5b,
-	0xe2, 0x2d, 0x7a, 0x70, 0xcf, 0x28, 0xdb, 0x1a, 0xd0, 0xf1, 0xe8, 0xfa, 0x2e, 0x69, 0x7e, 0x05,
-	0xe5, 0x8d, 0xff, 0xa5, 0x73, 0xf6, 0x0c, 0x3e, 0x12, 0xaa, 0x3f, 0xd3, 0xf3, 0x49, 0x5f, 0xdb,
-	0x4a, 0xe6, 0xff, 0xa5, 0x67, 0x7e, 0xad, 0xac, 0x57, 0xf6, 0xd0, 0x95, 0xf3, 0x73, 0x13, 0x4f,
-	0x7f, 0xf5, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44, 0xa9, 0x1d, 0x2f, 0x70, 0x07, 0x00, 0x00,
+	// 3019 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5a, 0xcb, 0x93, 0xdc, 0x48,
+	0xd1, 0xb7, 0xd4, 0xef, 0xec, 0x99, 0xb1, 0x5c, 0x7e, 0xe9, 0xeb, 0xf5, 0xf7, 0x7d, 0x5e, 0x05,
+	0x0b, 0xe3, 0xb1, 0x3d, 0xe1, 0xf5, 0x3e, 0xbc, 0xb1, 0xb1, 0xcb, 0xd2, 0xee, 0xf6, 0xd8, 0xed,
+	0xf5, 0xd8, 0x33, 0xd5, 0xb3, 0x8b, 0x83, 0xe0, 0x22, 0x77, 0x97, 0x67, 0xc4, 0x74, 0x4b, 0xbd,
+	0x92, 0x7a, 0xec, 0xd9, 0x03, 0xc1, 0x91, 0x00, 0x2e, 0x44, 0x70, 0xe1, 0x02, 0x17, 0x22, 0xb8,
+	0x70, 0x87, 0x2b, 0x01, 0x27, 0x22, 0xb8, 0xf2, 0x0f, 0x70, 0xe5, 0x2f, 0xe0, 0x02, 0x91, 0x59,
+	0x25, 0xa9, 0xf4, 0xf0, 0xf8, 0xb1, 0xa7, 0x56, 0xfe, 0x2a, 0x55, 0x95, 0x95, 0x99, 0x95, 0x99,
+	0x95, 0x6a, 0x58, 0x89, 0x44, 0x78, 0x24, 0xc2, 0xcd, 0x45, 0x18, 0xc4, 0x01, 0x6b, 0xd3, 0xcf,
+	0x24, 0x98, 0x39, 0xff, 0x0f, 0xad, 0xb1, 0x88, 0x22, 0x2f, 0xf0, 0xd9, 0x39, 0x68, 0xec, 0x05,
+	0x87, 0xc2, 0xb7, 0x8d, 0xcb, 0xc6, 0x7a, 0x87, 0x4b, 0xc2, 0xf9, 0x87, 0x09, 0x30, 0x14, 0x47,
+	0xde, 0x44, 0x8c, 0xfc, 0xa7, 0x01, 0x5b, 0x87, 0xfa, 0x43, 0x77, 0x2e, 0x88, 0x67, 0xed, 0xe6,
+	0xb9, 0xcd, 0x64, 0xa2, 0x4d, 0xc9, 0x83, 0x63, 0x9c, 0x38, 0xd8, 0x05, 0x68, 0x8e, 0x45, 0xe8,
+	0xb9, 0x33, 0xdb, 0xa4, 0xf9, 0x14, 0xc5, 0xae, 0xc1, 0x99, 0x6d, 0xf7, 0xb9, 0x37, 0x5f, 0xce,
+	0xc7, 0xee, 0x7c, 0x31, 0x13, 0xdc, 0x8d, 0x85, 0x5d, 0xbb, 0x6c, 0xac, 0xaf, 0xf2, 0xf2, 0x00,
+	0xdb, 0x00, 0x6b, 0xdb, 0xf3, 0x11, 0xdc, 0x0a, 0xc5, 0x57, 0x4b, 0xe1, 0x4f, 0x8e, 0xed, 0x26,
+	0x31, 0x97, 0x70, 0xe2, 0x75, 0x9f, 0xe7, 0x30, 0xbb, 0xa5, 0x78, 0x0b, 0x38, 0xfb, 0x16, 0xac,
+	0xf6, 0x87, 0x03, 0x2e, 0xa2, 0x60, 0xb6, 0x8c, 0xbd, 0xc0, 0xb7, 0xdb, 0xc4, 0x98, 0x07, 0x35,
+	0x59, 0xf9, 0xe3, 0xc1, 0x81, 0xeb, 0xfb, 0x62, 0x16, 0xd9, 0x9d, 0x9c, 0xac, 0xd9, 0x80, 0xc6,
+	0xbd, 0x97, 0x71, 0x43, 0x8e, 0x3b, 0x1b, 0x70, 0x3e, 0x49, 0xf4, 0xfa, 0xc0, 0x8b, 0x62, 0xb6,
+	0x09, 0x2d, 0x49, 0x45, 0xb6, 0x71, 0xb9, 0xb6, 0xde, 0x2d, 0xab, 0x16, 0xd5, 0xcf, 0x13, 0x26,
+	0xe7, 0x77, 0x06, 0xac, 0xc8, 0xe7, 0x41, 0xe0, 0x3f, 0xf5, 0xf6, 0xd9, 0xff, 0x01, 0x68, 0xfa,
+	0x44, 0xf3, 0x98, 0x5c, 0x43, 0x70, 0xfc, 0xd1, 0x91, 0x08, 0x23, 0x42, 0xc8, 0x24, 0xab, 0x5c,
+	0x43, 0xd8, 0x15, 0xa8, 0xf1, 0xc7, 0x03, 0xbb, 0x46, 0x8b, 0x5f, 0xcc, 0x16, 0x57, 0xf2, 0xca,
+	0x55, 0x38, 0xf2, 0x20, 0xeb, 0xde, 0xe3, 0x81, 0x5d, 0x7f, 0x09, 0xeb, 0xde, 0xe3, 0x81, 0xf3,
+	0x2b, 0x03, 0xba, 0x52, 0xcc, 0x71, 0x8c, 0x52, 0xac, 0x43, 0x1d, 0xf7, 0x41, 0xf2, 0xbd, 0x68,
+	0x8f, 0xc4, 0xc1, 0x36, 0xa1, 0x29, 0x27, 0x22, 0x59, 0xbb, 0x37, 0x2f, 0x14, 0x79, 0xd5, 0x32,
+	0x8a, 0x8b, 0x5d, 0x85, 0xc6, 0xe0, 0xc0, 0xf5, 0x7c, 0xb5, 0x83, 0xf3, 0x19, 0xfb, 0xed, 0x59,
+	0x30, 0x39, 0x54, 0xdc, 0x92, 0xc7, 0xf1, 0x12, 0xdd, 0xef, 0x2d, 0x7d, 0xc1, 0xae, 0xa6, 0x67,
+	0x40, 0xc9, 0x75, 0x26, 0x7b, 0x59, 0x0d, 0xf0, 0xf4, 0x94, 0xbc, 0xa6, 0x5c, 0xce, 0x3f, 0x6b,
+	0xb0, 0x9a, 0x53, 0x0c, 0x5b, 0x87, 0xd3, 0x03, 0xe1, 0xc7, 0x22, 0xcc, 0xbc, 0x54, 0x9a, 0xab,
+	0x08, 0xb3, 0x6f, 0xc3, 0xda, 0xc3, 0x20, 0x9c, 0xbb, 0x33, 0xef, 0x6b, 0x31, 0xbd, 0x8b, 0x9b,
+	0x33, 0x89, 0xb1, 0x80, 0xb2, 0xf7, 0xe1, 0x7c, 0xdf, 0x77, 0x67, 0xc1, 0xfe, 0x96, 0x37, 0x8b,
+	0x45, 0x78, 0xdb, 0xf5, 0xa7, 0xcf, 0xbc, 0x69, 0x7c, 0x40, 0xc7, 0xca, 0xe4, 0xd5, 0x83, 0xec,
+	0x43, 0xb8, 0x30, 0xf4, 0xf6, 0xbd, 0xd8, 0x9d, 0x15, 0x5f, 0xab, 0xd3, 0x6b, 0x2f, 0x18, 0x65,
+	0x36, 0xb4, 0xfa, 0x7e, 0x2c, 0x7c, 0xdf, 0xb5, 0x1b, 0x74, 0xb2, 0x13, 0x92, 0x39, 0xb0, 0x32,
+	0x1c, 0x0c, 0x82, 0x30, 0x14, 0x13, 0x3a, 0x53, 0x78, 0x50, 0xdb, 0x3c, 0x87, 0x21, 0xcf, 0x68,
+	0x57, 0xe3, 0x69, 0x49, 0x1e, 0x1d, 0x63, 0x97, 0xa1, 0xab, 0xd6, 0xa6, 0x4d, 0xb7, 0x49, 0x1c,
+	0x1d, 0x62, 0x16, 0xd4, 0xfa, 0x77, 0x07, 0x74, 0x14, 0xdb, 0x1c, 0x1f, 0xd9, 0x25, 0xe8, 0xf4,
+	0xef, 0x0e, 0xfa, 0x71, 0xec, 0x4e, 0x0e, 0xe9, 0xd0, 0x99, 0x3c, 0x03, 0x58, 0x0f, 0xda, 0xfd,
+	0xbb, 0x83, 0xa1, 0x98, 0xb8, 0xc7, 0x76, 0x97, 0x06, 0x53, 0x1a, 0x25, 0xea, 0xdf, 0x1d, 0x70,
+	0xf1, 0x54, 0x84, 0xc2, 0x9f, 0x08, 0x7b, 0x85, 0xc6, 0x73, 0x18, 0x9e, 0x9e, 0xfe, 0xdd, 0xc1,
+	0xb6, 0xfb, 0x9c, 0x04, 0x5a, 0x95, 0xa7, 0x2b, 0x43, 0x9c, 0xff, 0x18, 0x70, 0x46, 0xdf, 0x82,
+	0xf4, 0x76, 0x1b, 0x5a, 0xca, 0xf4, 0x64, 0xe1, 0x55, 0x9e, 0x90, 0x25, 0x4d, 0x99, 0xaf, 0xa0,
+	0xa9, 0x5a, 0x85, 0xa6, 0x2e, 0x41, 0x67, 0x38, 0x78, 0xf4, 0xf4, 0x69, 0x24, 0xe2, 0x91, 0x32,
+	0x5b, 0x06, 0xe8, 0xa3, 0xbb, 0x76, 0x23, 0x3f, 0xba, 0x8b, 0x21, 0x10, 0x65, 0x1f, 0xcd, 0x9f,
+	0xb8, 0x33, 0x17, 0x37, 0xde, 0x24, 0x8e, 0x3c, 0x88, 0x3e, 0xb8, 0x73, 0xe0, 0x46, 0x22, 0x63,
+	0x6b, 0x49, 0x1f, 0xcc, 0xa3, 0xce, 0xe7, 0x60, 0xe9, 0x92, 0x51, 0x50, 0xbb, 0x05, 0xed, 0x34,
+	0x0e, 0xca, 0xa8, 0xf6, 0x56, 0x76, 0x5a, 0x4a, 0xea, 0xe2, 0x29, 0xb3, 0x73, 0x0c, 0x6c, 0xe0,
+	0xce, 0xbc, 0x27, 0xa1, 0x8b, 0xa3, 0x1c, 0xcf, 0x43, 0x14, 0xbf, 0xde, 0x39, 0xd5, 0x74, 0x6f,
+	0xe6, 0x75, 0x7f, 0x09, 0x3a, 0xc5, 0x13, 0x92, 0x01, 0xce, 0x67, 0x70, 0x36, 0x3d, 0x80, 0x9a,
+	0xa2, 0xb3, 0x6c, 0x66, 0xe4, 0xb2, 0x99, 0x05, 0xb5, 0x9d, 0x9d, 0x6d, 0x5a, 0xc2, 0xe0, 0xf8,
+	0xe8, 0xfc, 0x00, 0x2e, 0x56, 0x4c, 0x40, 0xfa, 0xf8, 0x0c, 0xba, 0x19, 0x92, 0xa8, 0xe4, 0x7f,
+	0xb3, 0x4d, 0x54, 0xbc, 0xc7, 0xf5, 0x37, 0x9c, 0xbf, 0x18, 0xc0, 0x76, 0x76, 0xb6, 0xef, 0x44,
+	0xb1, 0x37, 0x47, 0x8d, 0xbd, 0x89, 0x62, 0x36, 0x81, 0xa5, 0x7e, 0x9d, 0x45, 0x20, 0xb9, 0x81,
+	0x8a, 0x11, 0x3c, 0x3a, 0xc3, 0xa5, 0x34, 0x04, 0x69, 0xcb, 0xe0, 0x29, 0x4d, 0x49, 0x47, 0xb8,
+	0xe1, 0xe4, 0x60, 0xbc, 0x70, 0x7d, 0xe5, 0x7f, 0x1a, 0x82, 0x25, 0x45, 0x7f, 0xb1, 0x98, 0x1d,
+	0x93, 0xf3, 0xb5, 0xb9, 0x24, 0x9c, 0x5f, 0x18, 0xd0, 0xd5, 0x76, 0xc1, 0x3e, 0x05, 0xd0, 0xdc,
+	0x5c, 0xee, 0xe0, 0x25, 0x5a, 0xd1, 0x5e, 0xa0, 0xb4, 0x2b, 0xdc, 0x68, 0x19, 0x8a, 0x69, 0x71,
+	0x3f, 0xe5, 0x01, 0x34, 0xd8, 0xf8, 0x21, 0x57, 0x76, 0xc7, 0x47, 0xe7, 0xf7, 0x35, 0xe8, 0x6a,
+	0x39, 0x82, 0x7d, 0x07, 0xea, 0x7b, 0xc7, 0x8b, 0xa4, 0xc4, 0x39, 0x5b, 0x48, 0x24, 0x38, 0xc4,
+	0x89, 0x01, 0x1d, 0x29, 0xbf, 0xa0, 0xc9, 0x33, 0x00, 0x75, 0x33, 0x14, 0x13, 0xdc, 0x61, 0xa2,
+	0xb9, 0x55, 0xae, 0x21, 0x78, 0xfc, 0x46, 0x18, 0xee, 0x17, 0xc1, 0x4c, 0xb2, 0xd4, 0x65, 0x05,
+	0x92, 0x03, 0xf3, 0xce, 0xda, 0x28, 0x38, 0x2b, 0xae, 0xb1, 0x17, 0xba, 0x7e, 0xe4, 0xa5, 0xe1,
+	0xd6, 0xe4, 0x1a, 0x82, 0x5b, 0xd9, 0x0e, 0xa6, 0xf2, 0xc8, 0xe6, 0xb6, 0x32, 0x14, 0xf3, 0x60,
+	0x8a, 0x43, 0x9c, 0x18, 0x28, 0x52, 0x88, 0x23, 0xcf, 0x4d, 0x4b, 0x21, 0x93, 0x67, 0x00, 0x9e,
+	0xa5, 0xad, 0xad, 0xbd, 0xb1, 0xf7, 0xb5, 0x50, 0xc5, 0x4f, 0x42, 0xe2, 0x7b, 0x7b, 0x07, 0xa1,
+	0x88, 0x0e, 0x82, 0xd9, 0x34, 0x89, 0xba, 0x29, 0xc0, 0x18, 0xd4, 0xa9, 0x1a, 0xe9, 0x92, 0x31,
+	0xea, 0x49, 0x1d, 0x72, 0xef, 0x38, 0x8a, 0x45, 0x28, 0x22, 0x2f, 0x52, 0xb1, 0x56, 0x43, 0xd0,
+	0xdd, 0xee, 0xb9, 0xfe, 0xfe, 0x9e, 0x37, 0x17, 0x2a, 0xce, 0xa6, 0xb4, 0xf3, 0x73, 0x03, 0x4e,
+	0xef, 0x84, 0xc1, 0x04, 0x3d, 0xd9, 0xdf, 0xa7, 0x54, 0xfe, 0x7a, 0xbe, 0x7f, 0x1d, 0x9a, 0x64,
+	0xc4, 0xc8, 0x36, 0x4f, 0xaa, 0x12, 0x14, 0x13, 0xee, 0x6e, 0xec, 0xf9, 0x87, 0x28, 0x77, 0x44,
+	0x75, 0x85, 0xc9, 0x33, 0xc0, 0xf9, 0xa5, 0x01, 0x16, 0x17, 0x93, 0x20, 0x9c, 0x7a, 0xfe, 0xfe,
+	0x1b, 0x1e, 0xc5, 0xe6, 0x16, 0x66, 0xf2, 0x98, 0xbc, 0x67, 0x4d, 0xaf, 0x25, 0x64, 0xe5, 0x26,
+	0x47, 0xb9, 0xe2, 0xa2, 0xbc, 0x28, 0xa2, 0x49, 0xe8, 0x2d, 0x52, 0x9f, 0xea, 0x70, 0x1d, 0x72,
+	0x7e, 0x6b, 0x40, 0x27, 0x95, 0x89, 0xad, 0x81, 0x39, 0x1a, 0xaa, 0x80, 0x65, 0x8e, 0x86, 0xaf,
+	0xbd, 0x9e, 0x0d, 0x2d, 0x89, 0x47, 0xb4, 0x56, 0x9d, 0x27, 0x24, 0x69, 0x26, 0x76, 0xc3, 0x98,
+	0xcc, 0x54, 0xa7, 0xb1, 0x0c, 0x40, 0x1b, 0x8e, 0xe3, 0x60, 0x41, 0x83, 0x0d, 0x1a, 0x4c, 0x69,
+	0xe7, 0x67, 0x06, 0xac, 0xa6, 0x12, 0x52, 0xa5, 0xf7, 0xae, 0x26, 0xb2, 0x52, 0x9a, 0xe6, 0xa9,
+	0x99, 0x86, 0xb5, 0x8d, 0x31, 0xa8, 0x93, 0x37, 0x9a, 0x34, 0x39, 0x3d, 0xe3, 0xa2, 0xdb, 0x22,
+	0x76, 0xa7, 0x6e, 0xec, 0x2a, 0xcd, 0xa4, 0x34, 0x46, 0xef, 0xfe, 0x24, 0xf6, 0x8e, 0xa4, 0xac,
+	0x6d, 0xae, 0x28, 0xe7, 0x9e, 0x26, 0x8b, 0xca, 0x58, 0x90, 0x02, 0x49, 0x80, 0xbe, 0x58, 0x21,
+	0x0c, 0x15, 0xaa, 0x1a, 0xab, 0xf3, 0x63, 0x58, 0x4b, 0xa9, 0xf1, 0xcc, 0x9b, 0x88, 0x92, 0xf2,
+	0x2f, 0x43, 0x97, 0x34, 0x24, 0xd3, 0xaf, 0x0a, 0x50, 0x3a, 0xf4, 0xb2, 0x48, 0xab, 0x45, 0x93,
+	0x7a, 0x31, 0x9a, 0x38, 0x7f, 0x35, 0xa1, 0x76, 0x3f, 0x78, 0x52, 0x5a, 0xf5, 0x3a, 0x34, 0x65,
+	0x59, 0xaa, 0xca, 0xd5, 0xf3, 0xc5, 0x72, 0x55, 0xa6, 0x5e, 0xc5, 0x94, 0xb7, 0x6b, 0xad, 0x68,
+	0xd7, 0x73, 0xd0, 0x18, 0xba, 0xde, 0xec, 0x58, 0x69, 0x51, 0x12, 0x39, 0xb1, 0x1b, 0x05, 0xb1,
+	0x33, 0x8f, 0x6b, 0xbe, 0x89, 0x87, 0xb7, 0x4a, 0x1e, 0x8e, 0x61, 0xf3, 0x81, 0x1b, 0xc5, 0x99,
+	0xc7, 0xb4, 0x89, 0x27, 0x0f, 0xe2, 0x3e, 0x10, 0xb8, 0x13, 0x86, 0x41, 0x48, 0x31, 0xab, 0xc3,
+	0x33, 0x00, 0xfd, 0x9a, 0x2f, 0x7d, 0x1f, 0xdf, 0x06, 0xda, 0x49, 0x42, 0x3a, 0xd7, 0xa0, 0x75,
+	0x3f, 0x78, 0x42, 0xae, 0xf0, 0x36, 0xd4, 0xef, 0x07, 0x4f, 0x12, 0x27, 0x58, 0xcd, 0x04, 0xbf,
+	0x1f, 0x3c, 0xe1, 0x34, 0xe4, 0x7c, 0x0c, 0x6b, 0x69, 0xbc, 0xe7, 0xae, 0xbf, 0x4f, 0x1a, 0x22,
+	0x75, 0x91, 0x05, 0x0c, 0x2e, 0x09, 0x72, 0xd7, 0x38, 0x58, 0x28, 0x9b, 0xd3, 0xb3, 0xf3, 0x13,
+	0x13, 0xba, 0xe3, 0x89, 0xfb, 0x66, 0xc5, 0xcd, 0x0d, 0x68, 0xd2, 0x7a, 0x49, 0x1c, 0xb3, 0x2b,
+	0xb2, 0x25, 0x31, 0x70, 0xc5, 0x97, 0x0f, 0xd4, 0xb5, 0x62, 0xa0, 0xd6, 0x02, 0x7c, 0x3d, 0x1f,
+	0xe0, 0xb1, 0x70, 0x3e, 0x12, 0xa1, 0x8b, 0x6b, 0x35, 0x68, 0x28, 0xa5, 0x65, 0xf6, 0x8f, 0xe3,
+	0x99, 0x20, 0x6f, 0x69, 0xd2, 0xe6, 0x34, 0x04, 0xc7, 0x07, 0x81, 0x1f, 0x7b, 0xfe, 0x32, 0x58,
+	0x46, 0xaa, 0xd0, 0xd7, 0x10, 0xe7, 0x87, 0xd0, 0x46, 0x0d, 0xec, 0x08, 0xf7, 0x30, 0x9f, 0x4b,
+	0xa5, 0xf2, 0x32, 0x00, 0xd5, 0xba, 0x13, 0x3c, 0x13, 0xa1, 0xca, 0xb2, 0x92, 0x28, 0x17, 0x72,
+	0x86, 0x5e, 0xc8, 0xfd, 0xc9, 0x04, 0x90, 0x0a, 0x8e, 0x96, 0xb3, 0x98, 0x14, 0xe0, 0xcd, 0x45,
+	0x14, 0xbb, 0xf3, 0x05, 0x2d, 0x50, 0xe7, 0x19, 0x50, 0x75, 0x27, 0x93, 0xc6, 0xaa, 0xba, 0x93,
+	0x91, 0x51, 0x33, 0x46, 0xb9, 0x72, 0x01, 0x45, 0x3f, 0x45, 0x3b, 0x67, 0x6c, 0x75, 0x62, 0xcb,
+	0x83, 0x6c, 0x1d, 0x1a, 0xb8, 0x7d, 0xd4, 0x2d, 0xda, 0x91, 0x69, 0x36, 0x57, 0x9a, 0xe1, 0x92,
+	0x81, 0x0c, 0x81, 0x41, 0xcb, 0x8b, 0x8f, 0x55, 0xa2, 0x4f, 0x69, 0x54, 0xf4, 0xc3, 0xc0, 0x8b,
+	0xc4, 0xd6, 0x2c, 0x08, 0x42, 0x55, 0x9f, 0x6b, 0x08, 0xfa, 0xdf, 0x8e, 0x1b, 0x45, 0xaa, 0xc7,
+	0x41, 0xcf, 0x38, 0x1f, 0xfe, 0x0e, 0x03, 0x5f, 0xa8, 0x6b, 0x54, 0x4a, 0x3b, 0x7f, 0x36, 0x61,
+	0x65, 0xfc, 0x4c, 0x88, 0xc5, 0x1b, 0x39, 0x67, 0x59, 0x43, 0xe6, 0xab, 0x69, 0xa8, 0x56, 0xa5,
+	0xa1, 0x1e, 0xb4, 0x6f, 0x7b, 0xfe, 0xf7, 0xd3, 0x7b, 0xa9, 0xc1, 0x53, 0xfa, 0x1b, 0x39, 0xa7,
+	0x0d, 0x2d, 0xec, 0x7e, 0xcc, 0xdc, 0x85, 0x52, 0x58, 0x42, 0x62, 0x3d, 0xa9, 0x5d, 0x3e, 0x54,
+	0xb8, 0x96, 0x35, 0x51, 0x79, 0x80, 0x2e, 0x06, 0xa8, 0xaa, 0xa4, 0x2f, 0xa4, 0x28, 0xe7, 0x6f,
+	0x06, 0x00, 0x3d, 0x6e, 0x85, 0xd8, 0x0d, 0x3b, 0xd9, 0xfd, 0xf4, 0x10, 0x2a, 0x73, 0x5a, 0x4a,
+	0xbf, 0xb2, 0xc3, 0x9d, 0xa4, 0xa8, 0xf4, 0xfc, 0x34, 0xa8, 0x88, 0x91, 0x04, 0xa2, 0x24, 0xa1,
+	0x6a, 0xa8, 0x49, 0x02, 0x9d, 0xe5, 0x5e, 0xb0, 0x88, 0x54, 0xe7, 0x8c, 0x9e, 0x9d, 0x7f, 0xd5,
+	0xa0, 0x39, 0xda, 0x1d, 0x62, 0x2a, 0x3d, 0x79, 0x23, 0xd7, 0xa0, 0x19, 0xc5, 0x6e, 0xbc, 0x8c,
+	0x54, 0x85, 0xa1, 0x75, 0x78, 0xc6, 0x84, 0x53, 0xf9, 0xac, 0x78, 0xf4, 0xfa, 0xa2, 0x4e, 0x82,
+	0x25, 0x24, 0x8a, 0x26, 0x63, 0xb7, 0xcc, 0xe4, 0x92, 0xa0, 0x14, 0x4a, 0x0c, 0x23, 0x7f, 0x2a,
+	0x9e, 0xab, 0xd2, 0x42, 0x87, 0xc8, 0xa7, 0xa8, 0xd1, 0x39, 0x0c, 0x83, 0xc5, 0x42, 0x4c, 0x69,
+	0x6b, 0x75, 0x9e, 0x07, 0xf1, 0xb4, 0x6f, 0x85, 0x81, 0x1f, 0x0b, 0x7f, 0x9a, 0xf0, 0xb5, 0x88,
+	0xaf, 0x08, 0xe3, 0x7c, 0xf7, 0xdc, 0x70, 0xfa, 0xcc, 0x0d, 0xd5, 0x9a, 0x6d, 0x39, 0x5f, 0x0e,
+	0xc4, 0xae, 0xd6, 0xe7, 0x9e, 0x3f, 0xb5, 0x3b, 0xa5, 0x3d, 0x93, 0x68, 0x38, 0xc6, 0x89, 0x43,
+	0x16, 0x2e, 0xfe, 0xa1, 0xea, 0x0a, 0xd2, 0x73, 0xa1, 0x73, 0xd7, 0x2d, 0x75, 0xee, 0x0a, 0xdd,
+	0x90, 0x95, 0x72, 0x37, 0xe4, 0x06, 0xb4, 0xc6, 0x5f, 0x2d, 0xc5, 0x6c, 0x72, 0x60, 0xaf, 0x16,
+	0x9b, 0x52, 0x6a, 0xe0, 0xce, 0x91, 0xf0, 0x63, 0x9e, 0xb0, 0x51, 0x9e, 0x97, 0x8f, 0x62, 0x6a,
+	0xaf, 0xa9, 0x3c, 0x9f, 0x00, 0xce, 0x1f, 0x0c, 0x58, 0xd1, 0xdf, 0x7b, 0x89, 0xd1, 0x4b, 0x4a,
+	0x32, 0xab, 0x94, 0x74, 0x0e, 0x1a, 0x54, 0x56, 0xab, 0xab, 0x90, 0x24, 0x50, 0x21, 0x8f, 0x16,
+	0xc2, 0x57, 0x15, 0x05, 0x3d, 0x27, 0x57, 0xf7, 0x7d, 0x31, 0x55, 0xf7, 0xc6, 0x84, 0xcc, 0xfc,
+	0xb8, 0xa9, 0xe5, 0x01, 0xe7, 0xdf, 0x26, 0x46, 0x92, 0x50, 0xb8, 0xf3, 0x41, 0x30, 0x9f, 0xbb,
+	0xfe, 0x54, 0xab, 0x82, 0xea, 0x54, 0x05, 0x5d, 0x51, 0x57, 0x3a, 0xe9, 0x94, 0x5a, 0x0d, 0xa4,
+	0x5e, 0xd0, 0x2e, 0x75, 0x5a, 0xa8, 0xab, 0xbd, 0x46, 0x33, 0xb0, 0xfe, 0x7a, 0x4d, 0xca, 0xc6,
+	0xcb, 0x9b, 0x94, 0x5a, 0xe9, 0xd6, 0x7c, 0x95, 0xd2, 0xcd, 0x81, 0x15, 0xd5, 0xe1, 0x90, 0x46,
+	0x90, 0xe7, 0x37, 0x87, 0xa1, 0xb6, 0xb5, 0x8e, 0x1a, 0x3d, 0xb3, 0x4f, 0x0b, 0xfd, 0x49, 0xf2,
+	0xe2, 0x13, 0xfa, 0xba, 0x79, 0x6e, 0xe7, 0xef, 0x26, 0x74, 0xa4, 0xf2, 0xfb, 0x93, 0xc3, 0x6f,
+	0xa2, 0xf8, 0x6b, 0xd0, 0x94, 0x21, 0xc2, 0xae, 0x95, 0x8e, 0x91, 0x16, 0x3a, 0xe4, 0x73, 0x16,
+	0x20, 0xea, 0x7a, 0x80, 0xc8, 0xec, 0xd1, 0x78, 0x25, 0x7b, 0x14, 0x02, 0x4a, 0xb3, 0x1c, 0x50,
+	0x72, 0x57, 0xc0, 0x56, 0xe1, 0x0a, 0xa8, 0x3b, 0x4b, 0xfb, 0xa5, 0xce, 0x52, 0x3a, 0x26, 0x9d,
+	0x8a, 0x63, 0xe2, 0xc4, 0xb0, 0x22, 0xd5, 0xa9, 0x36, 0xfa, 0xd2, 0x78, 0x3b, 0x7e, 0x85, 0x78,
+	0x3b, 0x4e, 0xe3, 0xed, 0xb6, 0x88, 0x22, 0x77, 0x5f, 0xa8, 0xb8, 0x9a, 0x90, 0xce, 0x4f, 0x8d,
+	0xe4, 0x08, 0x29, 0x84, 0x5d, 0x06, 0x73, 0xb4, 0xab, 0xb2, 0xbd, 0xa5, 0x77, 0xed, 0x30, 0x0b,
+	0x70, 0x73, 0xb4, 0xcb, 0xde, 0x81, 0x5a, 0x7f, 0x72, 0x68, 0x9b, 0xc5, 0x1b, 0x5b, 0xea, 0x0d,
+	0x1c, 0xc7, 0xd1, 0x26, 0x9a, 0x5d, 0xf3, 0xb1, 0x49, 0xdb, 0x68, 0x22, 0xa4, 0x33, 0x82, 0xd6,
+	0x97, 0x22, 0x4c, 0xbe, 0x48, 0x6d, 0xbb, 0x3f, 0x0a, 0x42, 0xd5, 0x3d, 0x95, 0x04, 0xa1, 0x9e,
+	0x1f, 0x84, 0xaa, 0xaf, 0x27, 0x09, 0x4a, 0x5b, 0x6e, 0x74, 0xa0, 0xa2, 0x0b, 0x3d, 0x3b, 0xbb,
+	0xb0, 0x26, 0x03, 0x3f, 0x5e, 0xd7, 0x28, 0x7b, 0x31, 0xed, 0xf3, 0x55, 0x47, 0x7d, 0xa8, 0xba,
+	0x9a, 0x2e, 0x68, 0x9b, 0x45, 0x23, 0xaa, 0x01, 0x9e, 0x70, 0x38, 0x2d, 0x68, 0xdc, 0x99, 0x2f,
+	0xe2, 0xe3, 0x0d, 0x91, 0x7c, 0x42, 0xa0, 0x39, 0xd6, 0x00, 0xf6, 0x44, 0x14, 0x8f, 0xbd, 0x7d,
+	0xdf, 0x9d, 0x59, 0xa7, 0x90, 0xee, 0x7b, 0x61, 0xb4, 0x38, 0xc6, 0x8f, 0x54, 0x96, 0xc1, 0x00,
+	0x9a, 0x7c, 0xef, 0xc1, 0x78, 0xc8, 0x2d, 0x93, 0x9d, 0x86, 0xee, 0x03, 0x6f, 0x2e, 0xc6, 0x43,
+	0x4e, 0x83, 0x35, 0x64, 0x56, 0xc0, 0x17, 0xe3, 0xdb, 0x56, 0x1d, 0x99, 0xef, 0xb9, 0x93, 0x43,
+	0xbe, 0x65, 0x35, 0x36, 0xae, 0x01, 0x64, 0x86, 0x64, 0x5d, 0x68, 0x8d, 0xfc, 0x23, 0x77, 0xe6,
+	0x4d, 0xad, 0x53, 0xac, 0x09, 0xe6, 0xa3, 0xcf, 0x2d, 0x83, 0x75, 0xd4, 0x51, 0xb0, 0xcc, 0x8d,
+	0xdf, 0x18, 0xd0, 0x49, 0xbb, 0x54, 0xec, 0x2c, 0x9c, 0xa6, 0x5e, 0xd0, 0xcc, 0x8d, 0x83, 0x90,
+	0x60, 0xeb, 0x14, 0x63, 0xb0, 0xa6, 0xae, 0x8d, 0x09, 0x66, 0x20, 0xc6, 0x85, 0xfc, 0x0e, 0xa4,
+	0x30, 0x92, 0x52, 0x35, 0xfe, 0x09, 0xa8, 0xb1, 0x73, 0x60, 0x51, 0xd7, 0x68, 0xa9, 0x4d, 0x57,
+	0x67, 0x2b, 0xd0, 0xde, 0xda, 0xda, 0x93, 0x54, 0x83, 0x59, 0x69, 0xde, 0x90, 0x48, 0x93, 0xad,
+	0xca, 0xf3, 0x23, 0xc9, 0xd6, 0xc6, 0x3b, 0xd0, 0x49, 0x5b, 0x4f, 0xb8, 0x9b, 0xad, 0x6d, 0x22,
+	0xad, 0x53, 0x48, 0xf4, 0x15, 0x61, 0x6c, 0xdc, 0x4e, 0x52, 0x22, 0x25, 0xcd, 0x55, 0xe8, 0x8c,
+	0x76, 0x25, 0x1d, 0x59, 0xa7, 0x70, 0x91, 0xfe, 0x72, 0xea, 0x05, 0x09, 0x62, 0xe0, 0x46, 0xc7,
+	0x0b, 0x31, 0x89, 0xc3, 0xe4, 0xd3, 0x61, 0x64, 0x99, 0x1b, 0xef, 0xc3, 0x8a, 0x7e, 0xc5, 0x64,
+	0x6d, 0xa8, 0x0f, 0xb6, 0xde, 0xbb, 0x69, 0x9d, 0xa2, 0xa7, 0xf1, 0xbb, 0x1f, 0x5a, 0x06, 0x6b,
+	0x41, 0x6d, 0x30, 0xfe, 0xc8, 0x32, 0xe9, 0xe1, 0x8b, 0x8f, 0xac, 0xda, 0xc6, 0xaf, 0x0d, 0xe8,
+	0x6a, 0xb1, 0x09, 0xd7, 0x7e, 0x18, 0x28, 0x40, 0x4a, 0x49, 0x65, 0xd7, 0x68, 0x57, 0x1a, 0x15,
+	0x6b, 0xd5, 0xd1, 0xae, 0x65, 0x92, 0x03, 0x2c, 0x7d, 0x21, 0x5d, 0xc2, 0xaa, 0xa1, 0x5e, 0xc6,
+	0x22, 0xa6, 0x40, 0x6e, 0xd5, 0x51, 0xc0, 0x9d, 0x30, 0x38, 0xf2, 0xd0, 0x85, 0x14, 0x4b, 0x43,
+	0x5a, 0x22, 0x8a, 0xc3, 0xe0, 0x58, 0x45, 0x08, 0xab, 0x49, 0xf3, 0x8b, 0x18, 0xe3, 0xb2, 0xd5,
+	0xc2, 0x39, 0xe5, 0x1c, 0x18, 0x6e, 0xad, 0xf6, 0xcd, 0x3f, 0x76, 0xa1, 0xcb, 0x5d, 0xdc, 0x39,
+	0x39, 0x35, 0xbb, 0x0e, 0x75, 0xba, 0xa9, 0x9e, 0xce, 0xfc, 0x95, 0x7c, 0xb3, 0x57, 0xfa, 0xae,
+	0x46, 0x6c, 0x1f, 0x40, 0x27, 0x15, 0x82, 0x55, 0x27, 0x93, 0x5e, 0x39, 0x7e, 0xb1, 0xeb, 0xd0,
+	0x52, 0x62, 0xb2, 0xf2, 0x68, 0xaf, 0xb8, 0x36, 0x76, 0x50, 0xb2, 0x33, 0x57, 0x16, 0xcd, 0xd6,
+	0xa7, 0xc8, 0x1d, 0xcd, 0xf7, 0xa1, 0x4e, 0x5f, 0xe3, 0x4a, 0xc2, 0x23, 0xda, 0x7b, 0x41, 0x24,
+	0x67, 0x9f, 0xc0, 0xe9, 0xbb, 0x22, 0xce, 0x41, 0x15, 0x52, 0xbe, 0xe8, 0xed, 0xeb, 0x50, 0xe7,
+	0x8f, 0x47, 0xbb, 0x55, 0xaf, 0x94, 0x62, 0xde, 0x0d, 0x83, 0x6d, 0xc1, 0x9a, 0x7c, 0x71, 0x19,
+	0x0a, 0x99, 0xa3, 0xff, 0x27, 0xe3, 0x2a, 0x34, 0x26, 0x7b, 0x2f, 0x1e, 0x62, 0xdf, 0x23, 0xa1,
+	0x73, 0x1f, 0x72, 0x2a, 0x24, 0xe8, 0x55, 0x7f, 0x2b, 0x21, 0x5b, 0xde, 0x81, 0x4e, 0x72, 0x15,
+	0x11, 0xec, 0x52, 0xc6, 0x58, 0xfe, 0x6a, 0x72, 0xe2, 0x34, 0x5f, 0xc2, 0x85, 0xb1, 0x88, 0xab,
+	0xbe, 0x77, 0x9c, 0xdc, 0x7f, 0xef, 0x9d, 0x3c, 0xcc, 0x1e, 0x82, 0x8d, 0xf3, 0x57, 0x0c, 0x45,
+	0x65, 0x97, 0x78, 0xfb, 0xc4, 0xb9, 0x48, 0x4e, 0x0e, 0x6f, 0x25, 0x5f, 0x0b, 0xaa, 0x96, 0xd3,
+	0x14, 0x50, 0xfe, 0x3a, 0xd2, 0x3b, 0x5f, 0x39, 0xca, 0x3e, 0x80, 0x3a, 0xde, 0xb1, 0xf5, 0x93,
+	0xa0, 0xf5, 0x63, 0x7a, 0xe7, 0x8a, 0x30, 0x76, 0x11, 0x6e, 0x18, 0xec, 0x96, 0xba, 0x34, 0x31,
+	0x3d, 0x8f, 0x69, 0x77, 0xe5, 0xde, 0xb9, 0x02, 0x4e, 0xf7, 0xbf, 0x1b, 0x06, 0xfb, 0x2e, 0x34,
+	0x65, 0xbe, 0x63, 0x17, 0x8b, 0x19, 0x50, 0xc5, 0x97, 0x5e, 0x69, 0x40, 0x25, 0xe3, 0x75, 0xe3,
+	0x86, 0xc1, 0xfa, 0xea, 0x1e, 0x98, 0x35, 0xb9, 0x7a, 0x55, 0x5d, 0x52, 0x25, 0x45, 0x55, 0x07,
+	0x95, 0xdd, 0x92, 0x37, 0xee, 0x0c, 0xa8, 0xf0, 0xba, 0xca, 0x17, 0x3f, 0x86, 0x35, 0xb4, 0x43,
+	0x0a, 0x54, 0x58, 0xb1, 0xaa, 0x4b, 0x4a, 0xb6, 0xeb, 0xc3, 0x99, 0x61, 0xf0, 0xcc, 0x9f, 0x05,
+	0xee, 0x34, 0x9b, 0xd0, 0xae, 0xe0, 0xa6, 0xb6, 0x69, 0xe5, 0xb9, 0xbb, 0x05, 0xa7, 0x87, 0x62,
+	0x26, 0x62, 0x91, 0xf2, 0xb2, 0x2a, 0x31, 0xcb, 0xc1, 0xe8, 0x0a, 0x74, 0x06, 0xa1, 0x70, 0x63,
+	0x81, 0xad, 0xd1, 0x7c, 0x0b, 0xaf, 0x97, 0x27, 0xd9, 0x26, 0xb4, 0x51, 0x5c, 0xec, 0xeb, 0x95,
+	0x37, 0x77, 0x26, 0xc7, 0x4b, 0xdb, 0xba, 0x8a, 0x27, 0xd0, 0x9f, 0x88, 0x59, 0xc5, 0xd4, 0xc5,
+	0xf7, 0x6f, 0x5f, 0x81, 0xb3, 0x5e, 0xb0, 0xb9, 0x1f, 0x2e, 0x26, 0x9b, 0x21, 0x06, 0x70, 0xf9,
+	0x67, 0x9c, 0xdb, 0x96, 0x16, 0xcd, 0x77, 0xf0, 0x8d, 0x1d, 0xe3, 0x49, 0x93, 0x5e, 0x7d, 0xef,
+	0xbf, 0x03, 0x00, 0x51, 0x15, 0x64, 0x44, 0xb1, 0x23, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RadioServerClient is the client API for RadioServer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RadioServerClient interface {
+	List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DeviceList, error)
+	Provision(ctx context.Context, in *DeviceState, opts ...grpc.CallOption) (*Session, error)
+	Destroy(ctx context.Context, in *Session, opts ...grpc.CallOption) (*Empty, error)
+	ServerInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerInfoData, error)
+	Tune(ctx context.Context, in *DeviceTune, opts ...grpc.CallOption) (*DeviceConfig, error)
+	GetDeviceConfig(ctx context.Context, in *Session, opts ...grpc.CallOption) (*DeviceConfig, error)
+	RXIQ(ctx context.Context, in *Session, opts ...grpc.CallOption) (RadioServer_RXIQClient, error)
+	ConfigureChain(ctx context.Context, in *ProcessingChain, opts ...grpc.CallOption) (*ProcessingChain, error)
+	GetIQCorrection(ctx context.Context, in *Session, opts ...grpc.CallOption) (*IQCorrectionList, error)
+	Calibrate(ctx context.Context, in *CalibrationRequest, opts ...grpc.CallOption) (*IQCorrectionList, error)
+	SetFrequencyCorrection(ctx context.Context, in *FrequencyCorrection, opts ...grpc.CallOption) (*FrequencyCorrection, error)
+	ListFrequencyCorrections(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FrequencyCorrectionList, error)
+	EstimateFrequencyCorrection(ctx context.Context, in *PPMEstimateRequest, opts ...grpc.CallOption) (*PPMEstimate, error)
+	Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (RadioServer_ScanClient, error)
+	Sweep(ctx context.Context, in *SweepRequest, opts ...grpc.CallOption) (RadioServer_SweepClient, error)
+	Stream(ctx context.Context, opts ...grpc.CallOption) (RadioServer_StreamClient, error)
+	StartRecording(ctx context.Context, in *RecordingRequest, opts ...grpc.CallOption) (*Recording, error)
+	StopRecording(ctx context.Context, in *Session, opts ...grpc.CallOption) (*Recording, error)
+	ListRecordings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RecordingList, error)
+	DownloadRecording(ctx context.Context, in *RecordingSlice, opts ...grpc.CallOption) (RadioServer_DownloadRecordingClient, error)
+	DeleteRecording(ctx context.Context, in *Recording, opts ...grpc.CallOption) (*Empty, error)
+	CreateJob(ctx context.Context, in *Job, opts ...grpc.CallOption) (*Job, error)
+	ListJobs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*JobList, error)
+	CancelJob(ctx context.Context, in *Job, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type radioServerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRadioServerClient(cc *grpc.ClientConn) RadioServerClient {
+	return &radioServerClient{cc}
+}
+
+func (c *radioServerClient) List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DeviceList, error) {
+	out := new(DeviceList)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) Provision(ctx context.Context, in *DeviceState, opts ...grpc.CallOption) (*Session, error) {
+	out := new(Session)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/Provision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) Destroy(ctx context.Context, in *Session, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/Destroy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) ServerInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerInfoData, error) {
+	out := new(ServerInfoData)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/ServerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) Tune(ctx context.Context, in *DeviceTune, opts ...grpc.CallOption) (*DeviceConfig, error) {
+	out := new(DeviceConfig)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/Tune", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) GetDeviceConfig(ctx context.Context, in *Session, opts ...grpc.CallOption) (*DeviceConfig, error) {
+	out := new(DeviceConfig)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/GetDeviceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) RXIQ(ctx context.Context, in *Session, opts ...grpc.CallOption) (RadioServer_RXIQClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RadioServer_serviceDesc.Streams[0], "/protocol.RadioServer/RXIQ", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &radioServerRXIQClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RadioServer_RXIQClient interface {
+	Recv() (*IQData, error)
+	grpc.ClientStream
+}
+
+type radioServerRXIQClient struct {
+	grpc.ClientStream
+}
+
+func (x *radioServerRXIQClient) Recv() (*IQData, error) {
+	m := new(IQData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *radioServerClient) ConfigureChain(ctx context.Context, in *ProcessingChain, opts ...grpc.CallOption) (*ProcessingChain, error) {
+	out := new(ProcessingChain)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/ConfigureChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) GetIQCorrection(ctx context.Context, in *Session, opts ...grpc.CallOption) (*IQCorrectionList, error) {
+	out := new(IQCorrectionList)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/GetIQCorrection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) Calibrate(ctx context.Context, in *CalibrationRequest, opts ...grpc.CallOption) (*IQCorrectionList, error) {
+	out := new(IQCorrectionList)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/Calibrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) SetFrequencyCorrection(ctx context.Context, in *FrequencyCorrection, opts ...grpc.CallOption) (*FrequencyCorrection, error) {
+	out := new(FrequencyCorrection)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/SetFrequencyCorrection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) ListFrequencyCorrections(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FrequencyCorrectionList, error) {
+	out := new(FrequencyCorrectionList)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/ListFrequencyCorrections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) EstimateFrequencyCorrection(ctx context.Context, in *PPMEstimateRequest, opts ...grpc.CallOption) (*PPMEstimate, error) {
+	out := new(PPMEstimate)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/EstimateFrequencyCorrection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (RadioServer_ScanClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RadioServer_serviceDesc.Streams[1], "/protocol.RadioServer/Scan", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &radioServerScanClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RadioServer_ScanClient interface {
+	Recv() (*ScanResult, error)
+	grpc.ClientStream
+}
+
+type radioServerScanClient struct {
+	grpc.ClientStream
+}
+
+func (x *radioServerScanClient) Recv() (*ScanResult, error) {
+	m := new(ScanResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *radioServerClient) Sweep(ctx context.Context, in *SweepRequest, opts ...grpc.CallOption) (RadioServer_SweepClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RadioServer_serviceDesc.Streams[2], "/protocol.RadioServer/Sweep", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &radioServerSweepClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RadioServer_SweepClient interface {
+	Recv() (*SweepFrame, error)
+	grpc.ClientStream
+}
+
+type radioServerSweepClient struct {
+	grpc.ClientStream
+}
+
+func (x *radioServerSweepClient) Recv() (*SweepFrame, error) {
+	m := new(SweepFrame)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *radioServerClient) Stream(ctx context.Context, opts ...grpc.CallOption) (RadioServer_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RadioServer_serviceDesc.Streams[3], "/protocol.RadioServer/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &radioServerStreamClient{stream}
+	return x, nil
+}
+
+type RadioServer_StreamClient interface {
+	Send(*StreamCommand) error
+	Recv() (*StreamMessage, error)
+	grpc.ClientStream
+}
+
+type radioServerStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *radioServerStreamClient) Send(m *StreamCommand) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *radioServerStreamClient) Recv() (*StreamMessage, error) {
+	m := new(StreamMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *radioServerClient) StartRecording(ctx context.Context, in *RecordingRequest, opts ...grpc.CallOption) (*Recording, error) {
+	out := new(Recording)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/StartRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) StopRecording(ctx context.Context, in *Session, opts ...grpc.CallOption) (*Recording, error) {
+	out := new(Recording)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/StopRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) ListRecordings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RecordingList, error) {
+	out := new(RecordingList)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/ListRecordings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (c *radioServerClient) DownloadRecording(ctx context.Context, in *RecordingSlice, opts ...grpc.CallOption) (RadioServer_DownloadRecordingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RadioServer_serviceDesc.Streams[4], "/protocol.RadioServer/DownloadRecording", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &radioServerDownloadRecordingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type RadioServer_DownloadRecordingClient interface {
+	Recv() (*IQData, error)
+	grpc.ClientStream
+}
 
-// RadioServerClient is the client API for RadioServer service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RadioServerClient interface {
-	List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DeviceList, error)
-	Provision(ctx context.Context, in *DeviceState, opts ...grpc.CallOption) (*Session, error)
-	Destroy(ctx context.Context, in *Session, opts ...grpc.CallOption) (*Empty, error)
-	ServerInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerInfoData, error)
-	Tune(ctx context.Context, in *DeviceTune, opts ...grpc.CallOption) (*DeviceConfig, error)
-	RXIQ(ctx context.Context, in *Session, opts ...grpc.CallOption) (RadioServer_RXIQClient, error)
+type radioServerDownloadRecordingClient struct {
+	grpc.ClientStream
 }
 
-type radioServerClient struct {
-	cc *grpc.ClientConn
+func (x *radioServerDownloadRecordingClient) Recv() (*IQData, error) {
+	m := new(IQData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func NewRadioServerClient(cc *grpc.ClientConn) RadioServerClient {
-	return &radioServerClient{cc}
+func (c *radioServerClient) DeleteRecording(ctx context.Context, in *Recording, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/DeleteRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *radioServerClient) List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DeviceList, error) {
-	out := new(DeviceList)
-	err := c.cc.Invoke(ctx, "/protocol.RadioServer/List", in, out, opts...)
+func (c *radioServerClient) CreateJob(ctx context.Context, in *Job, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/CreateJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *radioServerClient) ListJobs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*JobList, error) {
+	out := new(JobList)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/ListJobs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *radioServerClient) CancelJob(ctx context.Context, in *Job, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/protocol.RadioServer/CancelJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RadioServerServer is the server API for RadioServer service.
+type RadioServerServer interface {
+	List(context.Context, *Empty) (*DeviceList, error)
+	Provision(context.Context, *DeviceState) (*Session, error)
+	Destroy(context.Context, *Session) (*Empty, error)
+	ServerInfo(context.Context, *Empty) (*ServerInfoData, error)
+	Tune(context.Context, *DeviceTune) (*DeviceConfig, error)
+	GetDeviceConfig(context.Context, *Session) (*DeviceConfig, error)
+	RXIQ(*Session, RadioServer_RXIQServer) error
+	ConfigureChain(context.Context, *ProcessingChain) (*ProcessingChain, error)
+	GetIQCorrection(context.Context, *Session) (*IQCorrectionList, error)
+	Calibrate(context.Context, *CalibrationRequest) (*IQCorrectionList, error)
+	SetFrequencyCorrection(context.Context, *FrequencyCorrection) (*FrequencyCorrection, error)
+	ListFrequencyCorrections(context.Context, *Empty) (*FrequencyCorrectionList, error)
+	EstimateFrequencyCorrection(context.Context, *PPMEstimateRequest) (*PPMEstimate, error)
+	Scan(*ScanRequest, RadioServer_ScanServer) error
+	Sweep(*SweepRequest, RadioServer_SweepServer) error
+	Stream(RadioServer_StreamServer) error
+	StartRecording(context.Context, *RecordingRequest) (*Recording, error)
+	StopRecording(context.Context, *Session) (*Recording, error)
+	ListRecordings(context.Context, *Empty) (*RecordingList, error)
+	DownloadRecording(*RecordingSlice, RadioServer_DownloadRecordingServer) error
+	DeleteRecording(context.Context, *Recording) (*Empty, error)
+	CreateJob(context.Context, *Job) (*Job, error)
+	ListJobs(context.Context, *Empty) (*JobList, error)
+	CancelJob(context.Context, *Job) (*Empty, error)
+}
+
+// UnimplementedRadioServerServer can be embedded to have forward compatible implementations.
+type UnimplementedRadioServerServer struct {
+}
+
+func (*UnimplementedRadioServerServer) List(ctx context.Context, req *Empty) (*DeviceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedRadioServerServer) Provision(ctx context.Context, req *DeviceState) (*Session, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Provision not implemented")
+}
+func (*UnimplementedRadioServerServer) Destroy(ctx context.Context, req *Session) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Destroy not implemented")
+}
+func (*UnimplementedRadioServerServer) ServerInfo(ctx context.Context, req *Empty) (*ServerInfoData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerInfo not implemented")
+}
+func (*UnimplementedRadioServerServer) Tune(ctx context.Context, req *DeviceTune) (*DeviceConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tune not implemented")
+}
+func (*UnimplementedRadioServerServer) GetDeviceConfig(ctx context.Context, req *Session) (*DeviceConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceConfig not implemented")
+}
+func (*UnimplementedRadioServerServer) RXIQ(req *Session, srv RadioServer_RXIQServer) error {
+	return status.Errorf(codes.Unimplemented, "method RXIQ not implemented")
+}
+func (*UnimplementedRadioServerServer) ConfigureChain(ctx context.Context, req *ProcessingChain) (*ProcessingChain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureChain not implemented")
+}
+func (*UnimplementedRadioServerServer) GetIQCorrection(ctx context.Context, req *Session) (*IQCorrectionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIQCorrection not implemented")
+}
+func (*UnimplementedRadioServerServer) Calibrate(ctx context.Context, req *CalibrationRequest) (*IQCorrectionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Calibrate not implemented")
+}
+func (*UnimplementedRadioServerServer) SetFrequencyCorrection(ctx context.Context, req *FrequencyCorrection) (*FrequencyCorrection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFrequencyCorrection not implemented")
+}
+func (*UnimplementedRadioServerServer) ListFrequencyCorrections(ctx context.Context, req *Empty) (*FrequencyCorrectionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFrequencyCorrections not implemented")
+}
+func (*UnimplementedRadioServerServer) EstimateFrequencyCorrection(ctx context.Context, req *PPMEstimateRequest) (*PPMEstimate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFrequencyCorrection not implemented")
+}
+func (*UnimplementedRadioServerServer) Scan(req *ScanRequest, srv RadioServer_ScanServer) error {
+	return status.Errorf(codes.Unimplemented, "method Scan not implemented")
+}
+func (*UnimplementedRadioServerServer) Sweep(req *SweepRequest, srv RadioServer_SweepServer) error {
+	return status.Errorf(codes.Unimplemented, "method Sweep not implemented")
+}
+func (*UnimplementedRadioServerServer) Stream(srv RadioServer_StreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (*UnimplementedRadioServerServer) StartRecording(ctx context.Context, req *RecordingRequest) (*Recording, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRecording not implemented")
+}
+func (*UnimplementedRadioServerServer) StopRecording(ctx context.Context, req *Session) (*Recording, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRecording not implemented")
+}
+func (*UnimplementedRadioServerServer) ListRecordings(ctx context.Context, req *Empty) (*RecordingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecordings not implemented")
+}
+func (*UnimplementedRadioServerServer) DownloadRecording(req *RecordingSlice, srv RadioServer_DownloadRecordingServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadRecording not implemented")
+}
+func (*UnimplementedRadioServerServer) DeleteRecording(ctx context.Context, req *Recording) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecording not implemented")
+}
+func (*UnimplementedRadioServerServer) CreateJob(ctx context.Context, req *Job) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJob not implemented")
+}
+func (*UnimplementedRadioServerServer) ListJobs(ctx context.Context, req *Empty) (*JobList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (*UnimplementedRadioServerServer) CancelJob(ctx context.Context, req *Job) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+
+func RegisterRadioServerServer(s *grpc.Server, srv RadioServerServer) {
+	s.RegisterService(&_RadioServer_serviceDesc, srv)
+}
+
+func _RadioServer_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RadioServerServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).List(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RadioServer_Provision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RadioServerServer).Provision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/Provision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).Provision(ctx, req.(*DeviceState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RadioServer_Destroy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Session)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RadioServerServer).Destroy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/Destroy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).Destroy(ctx, req.(*Session))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RadioServer_ServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RadioServerServer).ServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/ServerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).ServerInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RadioServer_Tune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceTune)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RadioServerServer).Tune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/Tune",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).Tune(ctx, req.(*DeviceTune))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RadioServer_GetDeviceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Session)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RadioServerServer).GetDeviceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/GetDeviceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).GetDeviceConfig(ctx, req.(*Session))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RadioServer_RXIQ_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Session)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RadioServerServer).RXIQ(m, &radioServerRXIQServer{stream})
+}
+
+type RadioServer_RXIQServer interface {
+	Send(*IQData) error
+	grpc.ServerStream
+}
+
+type radioServerRXIQServer struct {
+	grpc.ServerStream
+}
+
+func (x *radioServerRXIQServer) Send(m *IQData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RadioServer_ConfigureChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessingChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RadioServerServer).ConfigureChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/ConfigureChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).ConfigureChain(ctx, req.(*ProcessingChain))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *radioServerClient) Provision(ctx context.Context, in *DeviceState, opts ...grpc.CallOption) (*Session, error) {
-	out := new(Session)
-	err := c.cc.Invoke(ctx, "/protocol.RadioServer/Provision", in, out, opts...)
-	if err != nil {
+func _RadioServer_GetIQCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Session)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RadioServerServer).GetIQCorrection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/GetIQCorrection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).GetIQCorrection(ctx, req.(*Session))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *radioServerClient) Destroy(ctx context.Context, in *Session, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/protocol.RadioServer/Destroy", in, out, opts...)
-	if err != nil {
+func _RadioServer_Calibrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalibrationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RadioServerServer).Calibrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/Calibrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).Calibrate(ctx, req.(*CalibrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *radioServerClient) ServerInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerInfoData, error) {
-	out := new(ServerInfoData)
-	err := c.cc.Invoke(ctx, "/protocol.RadioServer/ServerInfo", in, out, opts...)
-	if err != nil {
+func _RadioServer_SetFrequencyCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FrequencyCorrection)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RadioServerServer).SetFrequencyCorrection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/SetFrequencyCorrection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).SetFrequencyCorrection(ctx, req.(*FrequencyCorrection))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *radioServerClient) Tune(ctx context.Context, in *DeviceTune, opts ...grpc.CallOption) (*DeviceConfig, error) {
-	out := new(DeviceConfig)
-	err := c.cc.Invoke(ctx, "/protocol.RadioServer/Tune", in, out, opts...)
-	if err != nil {
+func _RadioServer_ListFrequencyCorrections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RadioServerServer).ListFrequencyCorrections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/ListFrequencyCorrections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).ListFrequencyCorrections(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *radioServerClient) RXIQ(ctx context.Context, in *Session, opts ...grpc.CallOption) (RadioServer_RXIQClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_RadioServer_serviceDesc.Streams[0], "/protocol.RadioServer/RXIQ", opts...)
-	if err != nil {
+func _RadioServer_EstimateFrequencyCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PPMEstimateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &radioServerRXIQClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(RadioServerServer).EstimateFrequencyCorrection(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/EstimateFrequencyCorrection",
 	}
-	return x, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).EstimateFrequencyCorrection(ctx, req.(*PPMEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type RadioServer_RXIQClient interface {
-	Recv() (*IQData, error)
-	grpc.ClientStream
+func _RadioServer_Scan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RadioServerServer).Scan(m, &radioServerScanServer{stream})
 }
 
-type radioServerRXIQClient struct {
-	grpc.ClientStream
+type RadioServer_ScanServer interface {
+	Send(*ScanResult) error
+	grpc.ServerStream
 }
 
-func (x *radioServerRXIQClient) Recv() (*IQData, error) {
-	m := new(IQData)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+type radioServerScanServer struct {
+	grpc.ServerStream
 }
 
-// RadioServerServer is the server API for RadioServer service.
-type RadioServerServer interface {
-	List(context.Context, *Empty) (*DeviceList, error)
-	Provision(context.Context, *DeviceState) (*Session, error)
-	Destroy(context.Context, *Session) (*Empty, error)
-	ServerInfo(context.Context, *Empty) (*ServerInfoData, error)
-	Tune(context.Context, *DeviceTune) (*DeviceConfig, error)
-	RXIQ(*Session, RadioServer_RXIQServer) error
+func (x *radioServerScanServer) Send(m *ScanResult) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-// UnimplementedRadioServerServer can be embedded to have forward compatible implementations.
-type UnimplementedRadioServerServer struct {
+func _RadioServer_Sweep_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SweepRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RadioServerServer).Sweep(m, &radioServerSweepServer{stream})
 }
 
-func (*UnimplementedRadioServerServer) List(ctx context.Context, req *Empty) (*DeviceList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+type RadioServer_SweepServer interface {
+	Send(*SweepFrame) error
+	grpc.ServerStream
 }
-func (*UnimplementedRadioServerServer) Provision(ctx context.Context, req *DeviceState) (*Session, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Provision not implemented")
+
+type radioServerSweepServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedRadioServerServer) Destroy(ctx context.Context, req *Session) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Destroy not implemented")
+
+func (x *radioServerSweepServer) Send(m *SweepFrame) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedRadioServerServer) ServerInfo(ctx context.Context, req *Empty) (*ServerInfoData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServerInfo not implemented")
+
+func _RadioServer_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RadioServerServer).Stream(&radioServerStreamServer{stream})
 }
-func (*UnimplementedRadioServerServer) Tune(ctx context.Context, req *DeviceTune) (*DeviceConfig, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tune not implemented")
+
+type RadioServer_StreamServer interface {
+	Send(*StreamMessage) error
+	Recv() (*StreamCommand, error)
+	grpc.ServerStream
 }
-func (*UnimplementedRadioServerServer) RXIQ(req *Session, srv RadioServer_RXIQServer) error {
-	return status.Errorf(codes.Unimplemented, "method RXIQ not implemented")
+
+type radioServerStreamServer struct {
+	grpc.ServerStream
 }
 
-func RegisterRadioServerServer(s *grpc.Server, srv RadioServerServer) {
-	s.RegisterService(&_RadioServer_serviceDesc, srv)
+func (x *radioServerStreamServer) Send(m *StreamMessage) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _RadioServer_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func (x *radioServerStreamServer) Recv() (*StreamCommand, error) {
+	m := new(StreamCommand)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _RadioServer_StartRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RadioServerServer).List(ctx, in)
+		return srv.(RadioServerServer).StartRecording(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.RadioServer/List",
+		FullMethod: "/protocol.RadioServer/StartRecording",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RadioServerServer).List(ctx, req.(*Empty))
+		return srv.(RadioServerServer).StartRecording(ctx, req.(*RecordingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RadioServer_Provision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceState)
+func _RadioServer_StopRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Session)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RadioServerServer).Provision(ctx, in)
+		return srv.(RadioServerServer).StopRecording(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.RadioServer/Provision",
+		FullMethod: "/protocol.RadioServer/StopRecording",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RadioServerServer).Provision(ctx, req.(*DeviceState))
+		return srv.(RadioServerServer).StopRecording(ctx, req.(*Session))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RadioServer_Destroy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Session)
+func _RadioServer_ListRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RadioServerServer).Destroy(ctx, in)
+		return srv.(RadioServerServer).ListRecordings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.RadioServer/Destroy",
+		FullMethod: "/protocol.RadioServer/ListRecordings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RadioServerServer).Destroy(ctx, req.(*Session))
+		return srv.(RadioServerServer).ListRecordings(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RadioServer_ServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _RadioServer_DownloadRecording_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RecordingSlice)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RadioServerServer).DownloadRecording(m, &radioServerDownloadRecordingServer{stream})
+}
+
+type RadioServer_DownloadRecordingServer interface {
+	Send(*IQData) error
+	grpc.ServerStream
+}
+
+type radioServerDownloadRecordingServer struct {
+	grpc.ServerStream
+}
+
+func (x *radioServerDownloadRecordingServer) Send(m *IQData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RadioServer_DeleteRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Recording)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RadioServerServer).ServerInfo(ctx, in)
+		return srv.(RadioServerServer).DeleteRecording(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.RadioServer/ServerInfo",
+		FullMethod: "/protocol.RadioServer/DeleteRecording",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RadioServerServer).ServerInfo(ctx, req.(*Empty))
+		return srv.(RadioServerServer).DeleteRecording(ctx, req.(*Recording))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RadioServer_Tune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceTune)
+func _RadioServer_CreateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Job)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RadioServerServer).Tune(ctx, in)
+		return srv.(RadioServerServer).CreateJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.RadioServer/Tune",
+		FullMethod: "/protocol.RadioServer/CreateJob",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RadioServerServer).Tune(ctx, req.(*DeviceTune))
+		return srv.(RadioServerServer).CreateJob(ctx, req.(*Job))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RadioServer_RXIQ_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Session)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _RadioServer_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(RadioServerServer).RXIQ(m, &radioServerRXIQServer{stream})
-}
-
-type RadioServer_RXIQServer interface {
-	Send(*IQData) error
-	grpc.ServerStream
-}
-
-type radioServerRXIQServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(RadioServerServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).ListJobs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *radioServerRXIQServer) Send(m *IQData) error {
-	return x.ServerStream.SendMsg(m)
+func _RadioServer_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Job)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RadioServerServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.RadioServer/CancelJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RadioServerServer).CancelJob(ctx, req.(*Job))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 var _RadioServer_serviceDesc = grpc.ServiceDesc{
@@ -1038,6 +4120,62 @@ var _RadioServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Tune",
 			Handler:    _RadioServer_Tune_Handler,
 		},
+		{
+			MethodName: "GetDeviceConfig",
+			Handler:    _RadioServer_GetDeviceConfig_Handler,
+		},
+		{
+			MethodName: "ConfigureChain",
+			Handler:    _RadioServer_ConfigureChain_Handler,
+		},
+		{
+			MethodName: "GetIQCorrection",
+			Handler:    _RadioServer_GetIQCorrection_Handler,
+		},
+		{
+			MethodName: "Calibrate",
+			Handler:    _RadioServer_Calibrate_Handler,
+		},
+		{
+			MethodName: "SetFrequencyCorrection",
+			Handler:    _RadioServer_SetFrequencyCorrection_Handler,
+		},
+		{
+			MethodName: "ListFrequencyCorrections",
+			Handler:    _RadioServer_ListFrequencyCorrections_Handler,
+		},
+		{
+			MethodName: "EstimateFrequencyCorrection",
+			Handler:    _RadioServer_EstimateFrequencyCorrection_Handler,
+		},
+		{
+			MethodName: "StartRecording",
+			Handler:    _RadioServer_StartRecording_Handler,
+		},
+		{
+			MethodName: "StopRecording",
+			Handler:    _RadioServer_StopRecording_Handler,
+		},
+		{
+			MethodName: "ListRecordings",
+			Handler:    _RadioServer_ListRecordings_Handler,
+		},
+		{
+			MethodName: "DeleteRecording",
+			Handler:    _RadioServer_DeleteRecording_Handler,
+		},
+		{
+			MethodName: "CreateJob",
+			Handler:    _RadioServer_CreateJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _RadioServer_ListJobs_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _RadioServer_CancelJob_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1045,6 +4183,27 @@ var _RadioServer_serviceDesc = grpc.ServiceDesc{
 			Handler:       _RadioServer_RXIQ_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Scan",
+			Handler:       _RadioServer_Scan_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Sweep",
+			Handler:       _RadioServer_Sweep_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Stream",
+			Handler:       _RadioServer_Stream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadRecording",
+			Handler:       _RadioServer_DownloadRecording_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "server.proto",
 }
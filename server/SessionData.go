@@ -1,13 +1,17 @@
 package server
 
 import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	uuid2 "github.com/gofrs/uuid"
 	"github.com/luigifreitas/radioserver/DSP"
 	"github.com/luigifreitas/radioserver/frontends"
 	"github.com/luigifreitas/radioserver/protocol"
-	fifo "github.com/racerxdl/go.fifo"
+	"github.com/luigifreitas/radioserver/sigmf"
 )
 
 const (
@@ -15,47 +19,181 @@ const (
 	maxFifoBuffs   = 4096
 )
 
+// IQBuffer is a chain output queued on the IQQueue of a session
+type IQBuffer struct {
+	DSP.Output
+
+	// HardwareIndex is the device sample counter of the first input sample and Timestamp its wall clock time
+	HardwareIndex uint64
+	Timestamp     uint64
+
+	// Squelch is set on the buffers carrying a squelch event instead of samples
+	Squelch *protocol.SquelchEvent
+}
+
+// streamCounters tracks the buffers sent on a stream to report the samples lost between them.
+// Buffers dropped by the session queue never reach the sender, so they show up as a jump of the sink index.
+type streamCounters struct {
+	sinks map[uint32]*sinkCounters
+}
+
+type sinkCounters struct {
+	nextIndex       uint64
+	frontendDropped uint64
+	squelched       uint64
+}
+
+// fill copies the buffer stream information to pb
+func (c *streamCounters) fill(b *IQBuffer, pb *protocol.IQData) {
+	if b.Squelch != nil {
+		return
+	}
+
+	pb.SampleIndex = b.Index
+	pb.HardwareIndex = b.HardwareIndex
+	pb.Timestamp = b.Timestamp
+	pb.Kind = b.Kind
+	pb.Sink = b.Sink
+	pb.SampleRate = float32(b.SampleRate)
+	pb.DigitalGain = b.Gain
+
+	if c.sinks == nil {
+		c.sinks = map[uint32]*sinkCounters{}
+	}
+
+	sc := c.sinks[b.Sink]
+	if sc != nil {
+		pb.FrontendDropped = b.FrontendDropped - sc.frontendDropped
+		pb.Squelched = b.Squelched - sc.squelched
+		pb.ServerDropped = b.Index - sc.nextIndex - pb.FrontendDropped - pb.Squelched
+	} else {
+		sc = &sinkCounters{}
+		c.sinks[b.Sink] = sc
+	}
+
+	sc.nextIndex = b.Index + uint64(b.Len())
+	sc.frontendDropped = b.FrontendDropped
+	sc.squelched = b.Squelched
+}
+
+// makeIQData converts a queued buffer to its message, IQ samples are interleaved
+func makeIQData(b *IQBuffer) *protocol.IQData {
+	if b.Squelch != nil {
+		return &protocol.IQData{
+			Timestamp: b.Timestamp,
+			Status:    protocol.StatusType_OK,
+			Squelch:   b.Squelch,
+		}
+	}
+
+	if b.Kind == protocol.SampleKind_IQSamples {
+		return protocol.MakeIQData(b.Complex)
+	}
+
+	return &protocol.IQData{
+		Timestamp: uint64(time.Now().UnixNano()),
+		Status:    protocol.StatusType_OK,
+		Samples:   b.Real,
+	}
+}
+
 type Session struct {
 	ID         string
 	LastUpdate time.Time
 
 	frontend frontends.Frontend
 
-	IQFifo *fifo.Queue
-	CG     *DSP.ChannelGenerator
+	IQQueue *DSP.Queue
+	CG      *DSP.ChannelGenerator
+	clock   *sampleClock
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	stopOnce sync.Once
 
-	fullStopped bool
+	// fullStopped and streaming are read atomically by the sample pipeline.
+	// streaming changes under recordLock, as it decides with the recording whether the chain output runs.
+	fullStopped int32
+	streaming   int32
+
+	// attached is set while a stream drains IQQueue
+	attached int32
+
+	recordLock sync.Mutex
+	recorder   *sigmf.Writer
+	recording  *protocol.Recording
+
+	// tuneLock guards the frequency correction of the device and the frequencies requested to it
+	tuneLock    sync.Mutex
+	ppm         float64
+	frequencies []float32
+
+	captureLock sync.Mutex
+	capture     *inputCapture
 }
 
-func GenerateSession(d *protocol.DeviceState) *Session {
+// GenerateSession provisions the device and starts its sample pipeline running the processing chain of d.
+// policy is applied to the queues between the frontend and the stream senders, ppm is the frequency error of the device.
+func GenerateSession(d *protocol.DeviceState, policy DSP.OverflowPolicy, ppm float64) (*Session, error) {
 	u, _ := uuid2.NewV4()
 	ID := u.String()
 
-	CG := DSP.CreateChannelGenerator()
+	// The frontend can't wait for the chain, a blocking policy only holds the chain back for the stream senders
+	inputPolicy := policy
+	if inputPolicy == DSP.Block {
+		inputPolicy = DSP.DropNewest
+	}
+	CG, err := DSP.CreateChannelGenerator(inputPolicy)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
 
 	s := &Session{
-		IQFifo:      fifo.NewQueue(),
-		ID:          ID,
-		LastUpdate:  time.Now(),
-		CG:          CG,
-		fullStopped: false,
+		IQQueue:    DSP.NewQueue(maxFifoBuffs, policy),
+		ID:         ID,
+		LastUpdate: time.Now(),
+		CG:         CG,
+		clock:      &sampleClock{},
+		ctx:        ctx,
+		cancel:     cancel,
+		ppm:        ppm,
+	}
+
+	// Validate the chain before opening the device
+	if d.Config != nil {
+		if err := CG.Configure(d.Chain, float64(d.Config.SampleRate)); err != nil {
+			cancel()
+			return nil, fmt.Errorf("invalid processing chain: %s", err)
+		}
 	}
 
 	s.frontend = s.ProvisionFrontend(d)
 	if s.frontend == nil {
-		return nil
+		cancel()
+		return nil, fmt.Errorf("error provisioning")
 	}
 
-	CG.SetOnIQ(func(samples []complex64) {
-		if s.IQFifo.Len() < maxFifoBuffs && !s.fullStopped {
-			s.IQFifo.Add(samples)
-		}
+	s.clock.setSampleRate(float64(s.frontend.GetDeviceConfig().SampleRate))
+
+	if err := CG.Configure(d.Chain, s.clock.rate()); err != nil {
+		cancel()
+		s.frontend.Destroy()
+		return nil, fmt.Errorf("invalid processing chain: %s", err)
+	}
+
+	s.applyChannelConfig(s.frontend.GetDeviceConfig())
+
+	CG.SetOnOutput(func(outputs []DSP.Output, hardwareIndex uint64) {
+		s.queueIQ(outputs, hardwareIndex)
+		s.record(outputs)
 	})
+	CG.SetOnSquelch(s.queueSquelch)
 
-	CG.Start()
+	CG.Start(ctx)
 	s.frontend.Start()
 
-	return s
+	return s, nil
 }
 
 func (s *Session) ProvisionFrontend(d *protocol.DeviceState) frontends.Frontend {
@@ -64,14 +202,451 @@ func (s *Session) ProvisionFrontend(d *protocol.DeviceState) frontends.Frontend
 		return nil
 	}
 
-	f := constructor(d)
+	hw := *d
+	s.tuneLock.Lock()
+	hw.Config = s.hardwareConfig(d.Config)
+	s.tuneLock.Unlock()
+
+	f := constructor(&hw)
+	if f == nil {
+		return nil
+	}
 	f.Init()
-	f.SetSamplesAvailableCallback(s.CG.PushSamples)
+	f.SetSamplesAvailableCallback(s.onSamples)
 	return f
 }
 
-func (s *Session) TuneFrontend(c *protocol.DeviceConfig) {
-  s.frontend.SetDeviceConfig(c)
+// TuneFrontend applies c to the device, correcting its center frequencies by the device frequency error.
+// The chain follows the sample rate the device reports, which can differ from the one requested. A rate the
+// chain can't process leaves the device at its previous rate.
+func (s *Session) TuneFrontend(c *protocol.DeviceConfig) error {
+	s.tuneLock.Lock()
+	previous := s.frontend.GetDeviceConfig()
+	hw := s.hardwareConfig(c)
+	applied := s.frontend.SetDeviceConfig(hw)
+
+	var err error
+	if applied.SampleRate != previous.SampleRate {
+		if s.IsRecording() {
+			// The recording metadata is written for the current rate
+			err = fmt.Errorf("can't change the sample rate while recording")
+		} else if e := s.CG.SetSampleRate(float64(applied.SampleRate)); e != nil {
+			err = fmt.Errorf("the chain doesn't fit a sample rate of %v: %s", applied.SampleRate, e)
+		}
+
+		if err != nil {
+			hw.SampleRate = previous.SampleRate
+			hw.Oversample = previous.Oversample
+			applied = s.frontend.SetDeviceConfig(hw)
+		}
+		// The timestamps follow the rate of the device counter from the next samples
+		s.clock.setSampleRate(float64(applied.SampleRate))
+	}
+	s.tuneLock.Unlock()
+
+	s.applyChannelConfig(applied)
+
+	s.recordLock.Lock()
+	defer s.recordLock.Unlock()
+	if s.recorder != nil {
+		if err := s.recorder.AddCapture(s.currentCapture()); err != nil {
+			log.Error("Error updating recording %s: %s", s.recording.ID, err)
+		}
+	}
+
+	return err
+}
+
+// hardwareConfig returns a copy of c with the center frequencies to request from the device, recording the
+// requested ones. The offset left by the frontend resolution on the first channel is shifted in DSP.
+// tuneLock must be held.
+func (s *Session) hardwareConfig(c *protocol.DeviceConfig) *protocol.DeviceConfig {
+	if c == nil {
+		return nil
+	}
+
+	hw := *c
+	hw.RXC = make([]*protocol.ChannelConfig, len(c.RXC))
+	s.frequencies = make([]float32, len(c.RXC))
+
+	for i, rxc := range c.RXC {
+		channel := *rxc
+		s.frequencies[i] = rxc.CenterFrequency
+
+		var residual float64
+		channel.CenterFrequency, residual = hardwareFrequency(rxc.CenterFrequency, s.ppm)
+		if i == 0 {
+			s.CG.SetFrequencyShift(residual)
+		}
+
+		hw.RXC[i] = &channel
+	}
+
+	return &hw
+}
+
+// DeviceConfig returns the device configuration as requested, before the frequency correction
+func (s *Session) DeviceConfig() protocol.DeviceConfig {
+	s.tuneLock.Lock()
+	defer s.tuneLock.Unlock()
+
+	c := s.frontend.GetDeviceConfig()
+	rxc := make([]*protocol.ChannelConfig, len(c.RXC))
+	for i, v := range c.RXC {
+		channel := *v
+		if i < len(s.frequencies) {
+			channel.CenterFrequency = s.frequencies[i]
+		}
+		rxc[i] = &channel
+	}
+	c.RXC = rxc
+
+	return c
+}
+
+// TuneChannel changes the receive channel index of the device with update, keeping the rest of its configuration
+func (s *Session) TuneChannel(index uint32, update func(*protocol.ChannelConfig)) error {
+	c := s.DeviceConfig()
+	if int(index) >= len(c.RXC) {
+		return fmt.Errorf("the device has %d receive channels", len(c.RXC))
+	}
+
+	update(c.RXC[index])
+	return s.TuneFrontend(&c)
+}
+
+// SetPPM changes the frequency error of the device, retuning it
+func (s *Session) SetPPM(ppm float64) {
+	c := s.DeviceConfig()
+
+	s.tuneLock.Lock()
+	s.ppm = ppm
+	s.tuneLock.Unlock()
+
+	if err := s.TuneFrontend(&c); err != nil {
+		log.Error("Error retuning %s: %s", s.ID, err)
+	}
+}
+
+// PPM returns the frequency error of the device
+func (s *Session) PPM() float64 {
+	s.tuneLock.Lock()
+	defer s.tuneLock.Unlock()
+	return s.ppm
+}
+
+// Serial returns the serial of the session device
+func (s *Session) Serial() string {
+	return s.frontend.GetDeviceInfo().Serial
+}
+
+// applyChannelConfig sets the IQ corrections and the digital gain as set on the channel config.
+// Frontends only deliver their first channel, which is the one processed.
+func (s *Session) applyChannelConfig(c protocol.DeviceConfig) {
+	if len(c.RXC) == 0 {
+		s.CG.SetCorrection(false, false)
+		s.CG.SetGain(DSP.GainSettings{})
+		return
+	}
+
+	rxc := c.RXC[0]
+	s.CG.SetCorrection(rxc.DCCorrection, rxc.IQCorrection)
+	s.CG.SetGain(DSP.GainSettings{
+		Gain:      float64(rxc.DigitalGain),
+		AGC:       rxc.AGC,
+		Attack:    float64(rxc.AGCAttack),
+		Decay:     float64(rxc.AGCDecay),
+		Reference: float64(rxc.AGCReference),
+		MaxGain:   float64(rxc.AGCMaxGain),
+	})
+}
+
+// IQCorrection returns the corrections currently applied to each channel
+func (s *Session) IQCorrection() *protocol.IQCorrectionList {
+	dc, iq, c := s.CG.Correction()
+
+	return &protocol.IQCorrectionList{
+		Channels: []*protocol.IQCorrectionState{
+			{
+				Channel:        0,
+				DCCorrection:   dc,
+				IQCorrection:   iq,
+				DCOffsetI:      real(c.DCOffset),
+				DCOffsetQ:      imag(c.DCOffset),
+				GainImbalance:  c.GainImbalance,
+				PhaseImbalance: c.PhaseImbalance,
+			},
+		},
+	}
+}
+
+// Calibrate runs the hardware calibration of a channel, restarting the estimation of the software corrections
+func (s *Session) Calibrate(channel uint32, bandwidth float64) error {
+	calibrator, ok := s.frontend.(frontends.Calibrator)
+	if !ok {
+		return fmt.Errorf("device doesn't support calibration")
+	}
+
+	if err := calibrator.Calibrate(int(channel), bandwidth); err != nil {
+		return err
+	}
+
+	if channel == 0 {
+		s.CG.ResetCorrection()
+	}
+
+	return nil
+}
+
+// onSamples receives the samples from the frontend
+func (s *Session) onSamples(samples []complex64, hardwareIndex uint64) {
+	s.clock.update(hardwareIndex)
+	s.feedCapture(samples)
+	s.CG.PushSamples(samples, hardwareIndex)
+}
+
+// queueIQ adds the chain outputs to IQQueue
+func (s *Session) queueIQ(outputs []DSP.Output, hardwareIndex uint64) {
+	if atomic.LoadInt32(&s.streaming) == 0 || atomic.LoadInt32(&s.fullStopped) != 0 {
+		return
+	}
+
+	timestamp := uint64(s.clock.timeOf(hardwareIndex).UnixNano())
+
+	for _, o := range outputs {
+		dropped := s.IQQueue.Push(s.ctx, &IQBuffer{
+			Output:        o,
+			HardwareIndex: hardwareIndex,
+			Timestamp:     timestamp,
+		})
+
+		if len(dropped) > 0 {
+			log.Debug("Session %s queue overflowing!", s.ID)
+		}
+	}
+}
+
+// queueSquelch adds the squelch events to IQQueue
+func (s *Session) queueSquelch(events []DSP.SquelchEvent, hardwareIndex uint64) {
+	if atomic.LoadInt32(&s.streaming) == 0 || atomic.LoadInt32(&s.fullStopped) != 0 {
+		return
+	}
+
+	for _, e := range events {
+		index := hardwareIndex + uint64(e.Delay*s.clock.rate())
+		timestamp := uint64(s.clock.timeOf(index).UnixNano())
+
+		if e.Changed {
+			log.Debug("Session %s squelch %d open: %v (%.1f dB)", s.ID, e.Block, e.Open, e.Power)
+		}
+
+		dropped := s.IQQueue.Push(s.ctx, &IQBuffer{
+			HardwareIndex: index,
+			Timestamp:     timestamp,
+			Squelch: &protocol.SquelchEvent{
+				Timestamp:     timestamp,
+				HardwareIndex: index,
+				Block:         uint32(e.Block),
+				Open:          e.Open,
+				Changed:       e.Changed,
+				Power:         e.Power,
+			},
+		})
+
+		if len(dropped) > 0 {
+			log.Debug("Session %s queue overflowing!", s.ID)
+		}
+	}
+}
+
+// ConfigureChain replaces the processing chain of the session while it runs
+func (s *Session) ConfigureChain(configs []*protocol.BlockConfig) error {
+	sampleRate := float64(s.frontend.GetDeviceConfig().SampleRate)
+
+	if s.IsRecording() {
+		// The recording metadata is written for the current first sink
+		next, err := DSP.MakeChain(configs, sampleRate)
+		if err != nil {
+			return err
+		}
+		if next.SinkKinds()[0] != protocol.SampleKind_IQSamples || next.SinkRates()[0] != s.CG.SinkRates()[0] {
+			return fmt.Errorf("can't change the recorded output while recording")
+		}
+	}
+
+	return s.CG.Configure(configs, sampleRate)
+}
+
+// SinkRates returns the output rate of each sink of the session chain
+func (s *Session) SinkRates() []float32 {
+	rates := s.CG.SinkRates()
+	r := make([]float32, len(rates))
+	for i, v := range rates {
+		r[i] = float32(v)
+	}
+	return r
+}
+
+// StartStreaming enables the IQ output of the session to IQQueue
+func (s *Session) StartStreaming() error {
+	s.recordLock.Lock()
+	defer s.recordLock.Unlock()
+
+	if !atomic.CompareAndSwapInt32(&s.streaming, 0, 1) {
+		return fmt.Errorf("already running")
+	}
+
+	s.CG.StartIQ()
+	return nil
+}
+
+// StopStreaming disables the IQ output of the session and discards what is still queued
+func (s *Session) StopStreaming() error {
+	s.recordLock.Lock()
+	defer s.recordLock.Unlock()
+
+	if !atomic.CompareAndSwapInt32(&s.streaming, 1, 0) {
+		return fmt.Errorf("not running")
+	}
+
+	if s.recorder == nil {
+		s.CG.StopIQ()
+	}
+
+	s.IQQueue.Clear()
+
+	return nil
+}
+
+// Boundary returns the device sample counter of the next sample from the frontend and the index
+// it will have in the first sink, telling apart the samples produced before and after a change
+func (s *Session) Boundary() (hardwareIndex, sampleIndex uint64) {
+	return s.CG.Boundary(0)
+}
+
+func (s *Session) IsStreaming() bool {
+	return atomic.LoadInt32(&s.streaming) != 0
+}
+
+// StartRecording starts writing the IQ output of the session to basePath as SigMF
+func (s *Session) StartRecording(id, basePath string, format protocol.SampleFormat, description string) (*protocol.Recording, error) {
+	s.recordLock.Lock()
+	defer s.recordLock.Unlock()
+
+	if s.recorder != nil {
+		return nil, fmt.Errorf("already recording")
+	}
+
+	if s.CG.SinkKinds()[0] != protocol.SampleKind_IQSamples {
+		return nil, fmt.Errorf("the first output of the chain is not IQ")
+	}
+
+	info := s.frontend.GetDeviceInfo()
+
+	w, err := sigmf.Create(basePath, format, sigmf.Global{
+		SampleRate:  s.CG.SinkRates()[0],
+		Description: description,
+		Recorder:    "radioserver",
+		Hardware:    fmt.Sprintf("%s %s", info.Name, info.Serial),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.AddCapture(s.currentCapture()); err != nil {
+		_ = w.Close()
+		return nil, err
+	}
+
+	s.recorder = w
+	s.recording = &protocol.Recording{
+		ID:        id,
+		Format:    format,
+		StartTime: uint64(time.Now().UnixNano()),
+	}
+
+	s.CG.StartIQ()
+
+	r := *s.recording
+	return &r, nil
+}
+
+// StopRecording finishes the current recording, if any
+func (s *Session) StopRecording() (*protocol.Recording, error) {
+	s.recordLock.Lock()
+	defer s.recordLock.Unlock()
+
+	if s.recorder == nil {
+		return nil, fmt.Errorf("not recording")
+	}
+
+	if !s.IsStreaming() {
+		s.CG.StopIQ()
+	}
+
+	r := s.recording
+	r.Samples = s.recorder.Samples()
+	r.StopTime = uint64(time.Now().UnixNano())
+	err := s.recorder.Close()
+
+	s.recorder = nil
+	s.recording = nil
+
+	return r, err
+}
+
+func (s *Session) IsRecording() bool {
+	s.recordLock.Lock()
+	defer s.recordLock.Unlock()
+	return s.recorder != nil
+}
+
+// RecordingID returns the ID of the current recording or an empty string if not recording
+func (s *Session) RecordingID() string {
+	s.recordLock.Lock()
+	defer s.recordLock.Unlock()
+	if s.recording == nil {
+		return ""
+	}
+	return s.recording.ID
+}
+
+// record writes the first sink output to the current recording
+func (s *Session) record(outputs []DSP.Output) {
+	s.recordLock.Lock()
+	defer s.recordLock.Unlock()
+
+	if s.recorder == nil {
+		return
+	}
+
+	for _, o := range outputs {
+		if o.Sink != 0 || o.Kind != protocol.SampleKind_IQSamples {
+			continue
+		}
+		if err := s.recorder.Write(o.Complex); err != nil {
+			log.Error("Error writing recording %s: %s", s.recording.ID, err)
+			return
+		}
+	}
+
+	// A recording session might not have any stream attached, so keep it from expiring
+	s.KeepAlive()
+}
+
+func (s *Session) currentCapture() sigmf.Capture {
+	c := sigmf.Capture{
+		DateTime: sigmf.FormatDateTime(time.Now()),
+	}
+
+	config := s.DeviceConfig()
+	if len(config.RXC) > 0 {
+		c.Frequency = float64(config.RXC[0].CenterFrequency)
+		c.Gain = config.RXC[0].NormalizedGain
+		c.Antenna = config.RXC[0].Antenna
+	}
+
+	return c
 }
 
 func (s *Session) Expired() bool {
@@ -82,13 +657,45 @@ func (s *Session) KeepAlive() {
 	s.LastUpdate = time.Now()
 }
 
+// Attach claims IQQueue for a stream, failing when another stream already drains it
+func (s *Session) Attach() error {
+	if !atomic.CompareAndSwapInt32(&s.attached, 0, 1) {
+		return fmt.Errorf("session already has a stream")
+	}
+	return nil
+}
+
+// Detach releases IQQueue for another stream
+func (s *Session) Detach() {
+	atomic.StoreInt32(&s.attached, 0)
+}
+
+// Done returns a channel that is closed when the session is fully stopped
+func (s *Session) Done() <-chan struct{} {
+	return s.ctx.Done()
+}
+
 func (s *Session) IsFullStopped() bool {
-	return s.fullStopped
+	return atomic.LoadInt32(&s.fullStopped) != 0
 }
 
+// FullStop stops the recording, the frontend and the channel generator of the session.
+// It is safe to call more than once.
 func (s *Session) FullStop() {
+	s.stopOnce.Do(s.fullStop)
+}
+
+func (s *Session) fullStop() {
+	if s.IsRecording() {
+		if _, err := s.StopRecording(); err != nil {
+			log.Error("Error closing recording: %s", err)
+		}
+	}
+	// Cancelling first unblocks anything waiting on the session queues
+	s.cancel()
 	s.frontend.Stop()
+	s.frontend.Destroy()
 	s.CG.StopIQ()
 	s.CG.Stop()
-	s.fullStopped = true
+	atomic.StoreInt32(&s.fullStopped, 1)
 }
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/luigifreitas/radioserver/frontends"
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+// Drivers returns the names of the frontend drivers known by the server
+func Drivers() []string {
+	var names []string
+	for name := range frontends.FindDevices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetDrivers only looks for devices with the frontend drivers named, every driver when empty
+func (rs *RadioServer) SetDrivers(drivers []string) error {
+	for _, name := range drivers {
+		if frontends.FindDevices[name] == nil {
+			return fmt.Errorf("unknown driver %s, the drivers are %v", name, Drivers())
+		}
+	}
+
+	rs.drivers = drivers
+	return nil
+}
+
+// SetDeviceAllowlist only serves the devices with the serials listed, every device when empty
+func (rs *RadioServer) SetDeviceAllowlist(serials []string) {
+	rs.allowedDevices = serials
+}
+
+// FindDevices returns the devices found by each enabled driver, allowed or not
+func (rs *RadioServer) FindDevices() map[string]*protocol.DeviceList {
+	found := map[string]*protocol.DeviceList{}
+	for name, finder := range frontends.FindDevices {
+		if !rs.driverEnabled(name) {
+			continue
+		}
+
+		var dl protocol.DeviceList
+		finder(&dl)
+		found[name] = &dl
+	}
+	return found
+}
+
+// devices returns the devices the server can provision
+func (rs *RadioServer) devices() *protocol.DeviceList {
+	var dl protocol.DeviceList
+	for _, name := range Drivers() {
+		if !rs.driverEnabled(name) {
+			continue
+		}
+
+		var found protocol.DeviceList
+		frontends.FindDevices[name](&found)
+
+		for _, d := range found.Devices {
+			if rs.deviceAllowed(d.Serial) {
+				dl.Devices = append(dl.Devices, d)
+			}
+		}
+	}
+	return &dl
+}
+
+func (rs *RadioServer) driverEnabled(name string) bool {
+	if len(rs.drivers) == 0 {
+		return true
+	}
+
+	for _, d := range rs.drivers {
+		if d == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (rs *RadioServer) deviceAllowed(serial string) bool {
+	if len(rs.allowedDevices) == 0 {
+		return true
+	}
+
+	for _, s := range rs.allowedDevices {
+		if s == serial {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDevice returns an error when the device of d is not served
+func (rs *RadioServer) checkDevice(d *protocol.DeviceState) error {
+	if d == nil || d.Info == nil {
+		return fmt.Errorf("no device")
+	}
+
+	if !rs.deviceAllowed(d.Info.Serial) {
+		return fmt.Errorf("device %s is not allowed", d.Info.Serial)
+	}
+
+	if len(rs.drivers) == 0 {
+		return nil
+	}
+
+	// Only the device list tells which driver handles a device
+	for _, dev := range rs.devices().Devices {
+		if dev.Name == d.Info.Name && dev.Serial == d.Info.Serial {
+			return nil
+		}
+	}
+	return fmt.Errorf("device %s %s is not available", d.Info.Name, d.Info.Serial)
+}
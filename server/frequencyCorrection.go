@@ -0,0 +1,288 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/luigifreitas/radioserver/DSP"
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+const (
+	frequencyCorrectionsFile = "frequency_corrections.json"
+
+	// maxPPM bounds the accepted frequency corrections
+	maxPPM = 200
+
+	// defaultPPMSearch is the frequency error searched around the reference carrier when no span is given, in ppm
+	defaultPPMSearch = 50
+
+	defaultPPMDuration = 0.5
+
+	// maxPPMSamples bounds the samples captured to estimate a frequency error
+	maxPPMSamples = 1 << 22
+
+	// minCarrierSNR is the power over the noise floor for a carrier to be trusted, in dB
+	minCarrierSNR = 10
+
+	captureTimeout = time.Second * 5
+)
+
+// ppmFactor returns the ratio between the actual and the nominal frequencies of a device off by ppm
+func ppmFactor(ppm float64) float64 {
+	return 1 + ppm*1e-6
+}
+
+// hardwareFrequency returns the center frequency to request from a device off by ppm to receive frequency,
+// and the offset left by the resolution of the request, which is shifted in DSP
+func hardwareFrequency(frequency float32, ppm float64) (float32, float64) {
+	k := ppmFactor(ppm)
+	hw := float32(float64(frequency) / k)
+	return hw, float64(frequency) - float64(hw)*k
+}
+
+// frequencyCorrection returns the frequency error saved for the device of d
+func (rs *RadioServer) frequencyCorrection(d *protocol.DeviceState) float64 {
+	if d == nil || d.Info == nil {
+		return 0
+	}
+
+	rs.correctionLock.Lock()
+	defer rs.correctionLock.Unlock()
+	return rs.corrections[d.Info.Serial]
+}
+
+// setFrequencyCorrection saves the frequency error of a device and retunes its sessions
+func (rs *RadioServer) setFrequencyCorrection(serial string, ppm float64) error {
+	if serial == "" {
+		return fmt.Errorf("no device serial")
+	}
+
+	if math.IsNaN(ppm) || math.Abs(ppm) > maxPPM {
+		return fmt.Errorf("frequency correction should be within %d ppm", maxPPM)
+	}
+
+	rs.correctionLock.Lock()
+	if ppm == 0 {
+		delete(rs.corrections, serial)
+	} else {
+		rs.corrections[serial] = ppm
+	}
+	rs.saveFrequencyCorrections()
+	rs.correctionLock.Unlock()
+
+	rs.sessionLock.Lock()
+	var sessions []*Session
+	for _, s := range rs.sessions {
+		if s.Serial() == serial {
+			sessions = append(sessions, s)
+		}
+	}
+	rs.sessionLock.Unlock()
+
+	for _, s := range sessions {
+		s.SetPPM(ppm)
+	}
+
+	log.Info("Frequency correction of %s set to %v ppm", serial, ppm)
+	return nil
+}
+
+func (rs *RadioServer) listFrequencyCorrections() *protocol.FrequencyCorrectionList {
+	rs.correctionLock.Lock()
+	defer rs.correctionLock.Unlock()
+
+	var l protocol.FrequencyCorrectionList
+	for serial, ppm := range rs.corrections {
+		l.Corrections = append(l.Corrections, &protocol.FrequencyCorrection{
+			Serial: serial,
+			PPM:    ppm,
+		})
+	}
+
+	sort.Slice(l.Corrections, func(i, j int) bool {
+		return l.Corrections[i].Serial < l.Corrections[j].Serial
+	})
+
+	return &l
+}
+
+// loadFrequencyCorrections restores the frequency corrections saved in the state folder
+func (rs *RadioServer) loadFrequencyCorrections() {
+	data, err := ioutil.ReadFile(filepath.Join(rs.statePath, frequencyCorrectionsFile))
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		log.Error("Error loading frequency corrections: %s", err)
+		return
+	}
+
+	corrections := map[string]float64{}
+	if err := json.Unmarshal(data, &corrections); err != nil {
+		log.Error("Error loading frequency corrections: %s", err)
+		return
+	}
+
+	rs.correctionLock.Lock()
+	rs.corrections = corrections
+	rs.correctionLock.Unlock()
+
+	log.Info("Loaded %d frequency corrections", len(corrections))
+}
+
+// saveFrequencyCorrections writes the frequency corrections to the state folder. correctionLock must be held.
+func (rs *RadioServer) saveFrequencyCorrections() {
+	data, err := json.MarshalIndent(rs.corrections, "", "   ")
+	if err != nil {
+		log.Error("Error saving frequency corrections: %s", err)
+		return
+	}
+
+	if err := os.MkdirAll(rs.statePath, 0755); err != nil {
+		log.Error("Error saving frequency corrections: %s", err)
+		return
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(rs.statePath, frequencyCorrectionsFile), data, 0644); err != nil {
+		log.Error("Error saving frequency corrections: %s", err)
+	}
+}
+
+// inputCapture collects the samples received from the frontend until full
+type inputCapture struct {
+	samples []complex64
+	done    chan struct{}
+}
+
+// feedCapture adds samples to the pending capture, if any
+func (s *Session) feedCapture(samples []complex64) {
+	s.captureLock.Lock()
+	defer s.captureLock.Unlock()
+
+	c := s.capture
+	if c == nil {
+		return
+	}
+
+	n := cap(c.samples) - len(c.samples)
+	if n > len(samples) {
+		n = len(samples)
+	}
+	c.samples = append(c.samples, samples[:n]...)
+
+	if len(c.samples) == cap(c.samples) {
+		close(c.done)
+		s.capture = nil
+	}
+}
+
+// captureInput returns the next n samples received from the frontend
+func (s *Session) captureInput(ctx context.Context, n int, timeout time.Duration) ([]complex64, error) {
+	c := &inputCapture{
+		samples: make([]complex64, 0, n),
+		done:    make(chan struct{}),
+	}
+
+	s.captureLock.Lock()
+	if s.capture != nil {
+		s.captureLock.Unlock()
+		return nil, fmt.Errorf("already capturing")
+	}
+	s.capture = c
+	s.captureLock.Unlock()
+
+	var err error
+	select {
+	case <-c.done:
+		return c.samples, nil
+	case <-time.After(timeout):
+		err = fmt.Errorf("timeout waiting for samples")
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-s.Done():
+		err = fmt.Errorf("session expired")
+	}
+
+	s.captureLock.Lock()
+	if s.capture == c {
+		s.capture = nil
+	}
+	s.captureLock.Unlock()
+
+	return nil, err
+}
+
+// estimatePPM measures the frequency error of the session device from a carrier at reference Hertz,
+// received on the first channel
+func (s *Session) estimatePPM(ctx context.Context, r *protocol.PPMEstimateRequest) (*protocol.PPMEstimate, error) {
+	config := s.frontend.GetDeviceConfig()
+	if len(config.RXC) == 0 || config.SampleRate == 0 {
+		return nil, fmt.Errorf("device has no receive channel")
+	}
+
+	if r.ReferenceFrequency <= 0 {
+		return nil, fmt.Errorf("invalid reference frequency")
+	}
+
+	duration := r.Duration
+	if duration <= 0 {
+		duration = defaultPPMDuration
+	}
+
+	sampleRate := float64(config.SampleRate)
+	n := int(math.Min(duration*sampleRate, maxPPMSamples))
+
+	span := float64(r.SearchSpan)
+	if span <= 0 {
+		span = r.ReferenceFrequency * defaultPPMSearch * 1e-6
+	}
+
+	// The samples come straight from the frontend, tuned to the hardware frequency
+	ppm := s.PPM()
+	lo := float64(config.RXC[0].CenterFrequency) * ppmFactor(ppm)
+	expected := r.ReferenceFrequency - lo
+
+	samples, err := s.captureInput(ctx, n, time.Duration(duration*float64(time.Second))+captureTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove the DC so it isn't taken for a carrier close to the center
+	var mean complex128
+	for _, v := range samples {
+		mean += complex128(v)
+	}
+	dc := complex64(mean / complex(float64(len(samples)), 0))
+	for i := range samples {
+		samples[i] -= dc
+	}
+
+	offset, snr, err := DSP.EstimateCarrierOffset(samples, sampleRate, expected, span)
+	if err != nil {
+		return nil, err
+	}
+
+	if snr < minCarrierSNR {
+		return nil, fmt.Errorf("no carrier found around %v Hz (%.1f dB over the noise)", r.ReferenceFrequency, snr)
+	}
+
+	// A carrier received offset by e Hertz from where expected means the oscillator is off by -e / lo
+	delta := (expected - offset) / lo
+
+	return &protocol.PPMEstimate{
+		Correction: &protocol.FrequencyCorrection{
+			Serial: s.Serial(),
+			PPM:    (ppmFactor(ppm)*(1+delta) - 1) * 1e6,
+		},
+		MeasuredFrequency: lo + offset,
+		SNR:               float32(snr),
+	}, nil
+}
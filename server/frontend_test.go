@@ -0,0 +1,158 @@
+package server
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/luigifreitas/radioserver/frontends"
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+func init() {
+	frontends.Available[protocol.DeviceName_TestSignal.String()] = newFakeFrontend
+}
+
+// fakeFrontend is a frontend without hardware, producing the samples handed to feed. Like a device with
+// a coarse clock, it runs at the requested sample rate rounded to 1 kHz.
+type fakeFrontend struct {
+	lock    sync.Mutex
+	info    protocol.DeviceInfo
+	config  protocol.DeviceConfig
+	cb      frontends.SamplesCallback
+	index   uint64
+	running bool
+}
+
+func newFakeFrontend(state *protocol.DeviceState) frontends.Frontend {
+	f := &fakeFrontend{info: *state.Info}
+	f.SetDeviceConfig(state.Config)
+	return f
+}
+
+func (f *fakeFrontend) GetDeviceInfo() protocol.DeviceInfo {
+	return f.info
+}
+
+func (f *fakeFrontend) GetDeviceConfig() protocol.DeviceConfig {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.config
+}
+
+func (f *fakeFrontend) SetDeviceConfig(c *protocol.DeviceConfig) protocol.DeviceConfig {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	applied := *c
+	applied.RXC = append([]*protocol.ChannelConfig(nil), c.RXC...)
+	if c.SampleRate > 0 {
+		applied.SampleRate = float32(math.Round(float64(c.SampleRate)/1e3) * 1e3)
+	} else {
+		applied.SampleRate = f.config.SampleRate
+	}
+
+	f.config = applied
+	return f.config
+}
+
+func (f *fakeFrontend) Init() bool { return true }
+
+func (f *fakeFrontend) Start() {
+	f.lock.Lock()
+	f.running = true
+	f.lock.Unlock()
+}
+
+func (f *fakeFrontend) Stop() {
+	f.lock.Lock()
+	f.running = false
+	f.lock.Unlock()
+}
+
+func (f *fakeFrontend) Destroy() {}
+
+func (f *fakeFrontend) SetSamplesAvailableCallback(cb frontends.SamplesCallback) {
+	f.cb = cb
+}
+
+// feed delivers n samples of a constant tone to the session
+func (f *fakeFrontend) feed(n int) {
+	samples := make([]complex64, n)
+	for i := range samples {
+		samples[i] = complex(0.5, 0.25)
+	}
+
+	f.lock.Lock()
+	index := f.index
+	f.index += uint64(n)
+	f.lock.Unlock()
+
+	f.cb(samples, index)
+}
+
+// testServer returns a server keeping its recordings and state in a temporary folder, removed by the cleanup
+func testServer(t *testing.T) (*RadioServer, func()) {
+	dir, err := ioutil.TempDir("", "radioserver")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs := MakeRadioServer("test")
+	rs.SetRecordingsPath(filepath.Join(dir, "recordings"))
+	rs.SetStatePath(filepath.Join(dir, "state"))
+
+	return rs, func() {
+		rs.sessionLock.Lock()
+		var tokens []string
+		for token := range rs.sessions {
+			tokens = append(tokens, token)
+		}
+		rs.sessionLock.Unlock()
+
+		for _, token := range tokens {
+			_ = rs.destroy(token)
+		}
+		_ = os.RemoveAll(dir)
+	}
+}
+
+// testDevice is the state of a fake device at sampleRate
+func testDevice(sampleRate float32) *protocol.DeviceState {
+	return &protocol.DeviceState{
+		Info: &protocol.DeviceInfo{
+			Name:   protocol.DeviceName_TestSignal,
+			Serial: "fake",
+		},
+		Config: &protocol.DeviceConfig{
+			SampleRate: sampleRate,
+			RXC: []*protocol.ChannelConfig{
+				{CenterFrequency: 100e6},
+			},
+		},
+	}
+}
+
+// provisionFake opens a fake device at sampleRate in a new session
+func provisionFake(t *testing.T, rs *RadioServer, sampleRate float32) (*Session, *fakeFrontend) {
+	s, err := rs.provision(testDevice(sampleRate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, s.frontend.(*fakeFrontend)
+}
+
+// waitFor polls cond until it holds, failing the test after a second
+func waitFor(t *testing.T, what string, cond func() bool) {
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// ListenHTTP serves the WebSocket gateway and the REST API on every address, alongside the RPC calls
+func (rs *RadioServer) ListenHTTP(addresses ...string) error {
+	if rs.httpServer != nil {
+		return fmt.Errorf("HTTP server already running")
+	}
+
+	var listeners []net.Listener
+	for _, address := range addresses {
+		lis, err := net.Listen("tcp", address)
+		if err != nil {
+			for _, l := range listeners {
+				_ = l.Close()
+			}
+			return err
+		}
+		log.Info("HTTP listening on %s", lis.Addr())
+		listeners = append(listeners, lis)
+	}
+
+	rs.httpServer = &http.Server{
+		Handler: rs.httpHandler(),
+	}
+
+	for _, lis := range listeners {
+		go rs.serveHTTP(rs.httpServer, lis)
+	}
+	return nil
+}
+
+// httpHandler routes the HTTP requests
+func (rs *RadioServer) httpHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/ws", websocket.Server{
+		Handler:   rs.serveWebSocket,
+		Handshake: rs.checkOrigin,
+	})
+	mux.Handle(restPrefix+"/", rs.restHandler())
+	return mux
+}
+
+// SetHTTPOrigins allows the pages of the origins listed, like https://example.com, to open a WebSocket.
+// A * allows every origin.
+func (rs *RadioServer) SetHTTPOrigins(origins []string) {
+	rs.httpOrigins = origins
+}
+
+// checkOrigin refuses the WebSocket handshakes of the pages from other sites, which would use the connection
+// on behalf of a visitor of theirs. Browsers send the origin of the page, other clients none and are accepted.
+func (rs *RadioServer) checkOrigin(config *websocket.Config, req *http.Request) error {
+	origin, err := websocket.Origin(config, req)
+	if err != nil {
+		return err
+	}
+	config.Origin = origin
+	if origin == nil || origin.Host == req.Host {
+		return nil
+	}
+
+	o := origin.Scheme + "://" + origin.Host
+	for _, allowed := range rs.httpOrigins {
+		if allowed == "*" || strings.TrimSuffix(allowed, "/") == o {
+			return nil
+		}
+	}
+
+	log.Warn("WebSocket from %s refused, origin %s not allowed", req.RemoteAddr, o)
+	return fmt.Errorf("origin %s not allowed", o)
+}
+
+func (rs *RadioServer) serveHTTP(srv *http.Server, lis net.Listener) {
+	err := srv.Serve(lis)
+	if err != nil && err != http.ErrServerClosed {
+		log.Error("HTTP Error: %s", err)
+	}
+}
+
+// stopHTTP closes the HTTP server, waiting up to timeout for the pending requests. A zero timeout closes it immediately.
+func (rs *RadioServer) stopHTTP(timeout time.Duration) {
+	if rs.httpServer == nil {
+		return
+	}
+	log.Info("Stopping HTTP Server")
+
+	if timeout == 0 {
+		_ = rs.httpServer.Close()
+		rs.httpServer = nil
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if err := rs.httpServer.Shutdown(ctx); err != nil {
+		log.Warn("Timeout waiting for the HTTP requests to finish, closing connections")
+		_ = rs.httpServer.Close()
+	}
+	rs.httpServer = nil
+}
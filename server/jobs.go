@@ -0,0 +1,256 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	uuid2 "github.com/gofrs/uuid"
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+const jobsFile = "jobs.json"
+
+func (rs *RadioServer) createJob(j *protocol.Job) (*protocol.Job, error) {
+	if j.Device == nil || j.Device.Info == nil || j.Device.Config == nil {
+		return nil, fmt.Errorf("job has no device")
+	}
+
+	if j.Duration <= 0 {
+		return nil, fmt.Errorf("job has no duration")
+	}
+
+	u, _ := uuid2.NewV4()
+	j.ID = u.String()
+	j.LastRecording = ""
+	j.LastError = ""
+	j.Running = false
+
+	if j.StartTime == 0 {
+		j.StartTime = uint64(time.Now().UnixNano())
+	}
+
+	rs.jobLock.Lock()
+	defer rs.jobLock.Unlock()
+
+	rs.jobs[j.ID] = j
+	rs.saveJobs()
+
+	c := *j
+	return &c, nil
+}
+
+func (rs *RadioServer) listJobs() *protocol.JobList {
+	rs.jobLock.Lock()
+	defer rs.jobLock.Unlock()
+
+	var jl protocol.JobList
+	for _, j := range rs.jobs {
+		c := *j
+		jl.Jobs = append(jl.Jobs, &c)
+	}
+
+	return &jl
+}
+
+func (rs *RadioServer) cancelJob(id string) error {
+	rs.jobLock.Lock()
+	defer rs.jobLock.Unlock()
+
+	if rs.jobs[id] == nil {
+		return fmt.Errorf("job doesn't exist")
+	}
+
+	if stop := rs.jobStop[id]; stop != nil {
+		close(stop)
+		delete(rs.jobStop, id)
+	}
+
+	delete(rs.jobs, id)
+	rs.saveJobs()
+
+	return nil
+}
+
+// checkJobs starts every job that is due
+func (rs *RadioServer) checkJobs() {
+	rs.jobLock.Lock()
+	defer rs.jobLock.Unlock()
+
+	if rs.isShuttingDown() {
+		return
+	}
+
+	now := uint64(time.Now().UnixNano())
+	for id, j := range rs.jobs {
+		if j.Running || j.StartTime > now {
+			continue
+		}
+
+		stop := make(chan bool)
+		rs.jobStop[id] = stop
+		j.Running = true
+		rs.jobsWg.Add(1)
+		go rs.runJob(j, stop)
+	}
+}
+
+func (rs *RadioServer) runJob(j *protocol.Job, stop chan bool) {
+	defer rs.jobsWg.Done()
+	log.Info("Running job %s", j.ID)
+
+	rec, err := rs.captureJob(j, stop)
+
+	rs.jobLock.Lock()
+	defer rs.jobLock.Unlock()
+
+	if rs.jobStop[j.ID] == stop {
+		delete(rs.jobStop, j.ID)
+	}
+
+	j.Running = false
+	j.LastError = ""
+	if err != nil {
+		log.Error("Job %s failed: %s", j.ID, err)
+		j.LastError = err.Error()
+	}
+	if rec != nil {
+		j.LastRecording = rec.ID
+	}
+
+	if rs.jobs[j.ID] == nil {
+		// Cancelled while running
+		return
+	}
+
+	if rs.isShuttingDown() {
+		// Keep the schedule as it was, so the interrupted run is retried after a restart
+		return
+	}
+
+	if j.Daily {
+		j.StartTime = nextDailyRun(j.StartTime)
+	} else {
+		delete(rs.jobs, j.ID)
+	}
+
+	rs.saveJobs()
+}
+
+// captureJob provisions the job device, records it for the job duration and releases it
+func (rs *RadioServer) captureJob(j *protocol.Job, stop chan bool) (*protocol.Recording, error) {
+	if err := rs.checkDevice(j.Device); err != nil {
+		return nil, err
+	}
+
+	rs.sessionLock.Lock()
+	s, err := GenerateSession(j.Device, rs.overflowPolicy, rs.frequencyCorrection(j.Device))
+	if err != nil {
+		rs.sessionLock.Unlock()
+		return nil, err
+	}
+	rs.sessions[s.ID] = s
+	rs.sessionLock.Unlock()
+
+	defer func() {
+		rs.sessionLock.Lock()
+		delete(rs.sessions, s.ID)
+		rs.sessionLock.Unlock()
+		s.FullStop()
+	}()
+
+	description := j.Description
+	if description == "" {
+		description = fmt.Sprintf("Job %s", j.ID)
+	}
+
+	if _, err := rs.startRecording(s, j.Format, description); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-time.After(time.Duration(j.Duration * float64(time.Second))):
+	case <-stop:
+		log.Warn("Job %s stopped before finishing", j.ID)
+	}
+
+	return s.StopRecording()
+}
+
+// stopJobs interrupts the running jobs and waits for them to release their devices
+func (rs *RadioServer) stopJobs() {
+	rs.jobLock.Lock()
+	for id, stop := range rs.jobStop {
+		close(stop)
+		delete(rs.jobStop, id)
+	}
+	rs.jobLock.Unlock()
+
+	rs.jobsWg.Wait()
+}
+
+// loadJobs restores the jobs saved in the state folder
+func (rs *RadioServer) loadJobs() {
+	data, err := ioutil.ReadFile(filepath.Join(rs.statePath, jobsFile))
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		log.Error("Error loading jobs: %s", err)
+		return
+	}
+
+	var jobs []*protocol.Job
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		log.Error("Error loading jobs: %s", err)
+		return
+	}
+
+	rs.jobLock.Lock()
+	defer rs.jobLock.Unlock()
+
+	now := uint64(time.Now().UnixNano())
+	for _, j := range jobs {
+		j.Running = false
+		if j.Daily {
+			// Skip the runs missed while the server was down
+			for j.StartTime < now {
+				j.StartTime = nextDailyRun(j.StartTime)
+			}
+		}
+		rs.jobs[j.ID] = j
+	}
+
+	log.Info("Loaded %d jobs", len(jobs))
+}
+
+// saveJobs writes the jobs to the state folder. jobLock must be held.
+func (rs *RadioServer) saveJobs() {
+	jobs := make([]*protocol.Job, 0, len(rs.jobs))
+	for _, j := range rs.jobs {
+		jobs = append(jobs, j)
+	}
+
+	data, err := json.MarshalIndent(jobs, "", "   ")
+	if err != nil {
+		log.Error("Error saving jobs: %s", err)
+		return
+	}
+
+	if err := os.MkdirAll(rs.statePath, 0755); err != nil {
+		log.Error("Error saving jobs: %s", err)
+		return
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(rs.statePath, jobsFile), data, 0644); err != nil {
+		log.Error("Error saving jobs: %s", err)
+	}
+}
+
+// nextDailyRun returns the same wall clock time on the next day
+func nextDailyRun(t uint64) uint64 {
+	return uint64(time.Unix(0, int64(t)).AddDate(0, 0, 1).UnixNano())
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+// testJob is a recording job of the fake device for duration seconds, starting at start
+func testJob(start time.Time, duration float64, daily bool) *protocol.Job {
+	return &protocol.Job{
+		Device:    testDevice(48000),
+		StartTime: uint64(start.UnixNano()),
+		Daily:     daily,
+		Duration:  duration,
+	}
+}
+
+// job returns a copy of the job id, nil when it doesn't exist
+func (rs *RadioServer) job(id string) *protocol.Job {
+	rs.jobLock.Lock()
+	defer rs.jobLock.Unlock()
+	if rs.jobs[id] == nil {
+		return nil
+	}
+	j := *rs.jobs[id]
+	return &j
+}
+
+func TestCreateJobValidation(t *testing.T) {
+	rs, cleanup := testServer(t)
+	defer cleanup()
+
+	tests := []struct {
+		name string
+		job  *protocol.Job
+	}{
+		{"no device", &protocol.Job{Duration: 1}},
+		{"no config", &protocol.Job{Device: &protocol.DeviceState{Info: &protocol.DeviceInfo{}}, Duration: 1}},
+		{"no duration", testJob(time.Now(), 0, false)},
+	}
+
+	for _, tt := range tests {
+		if _, err := rs.createJob(tt.job); err == nil {
+			t.Errorf("%s: job should be refused", tt.name)
+		}
+	}
+}
+
+func TestJobPersistence(t *testing.T) {
+	rs, cleanup := testServer(t)
+	defer cleanup()
+
+	later := time.Now().Add(time.Hour)
+	once, err := rs.createJob(testJob(later, 1, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two runs of the daily job were missed while the server was down
+	missed := time.Now().Add(-time.Hour * 47)
+	daily, err := rs.createJob(testJob(missed, 1, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restarted := MakeRadioServer("test")
+	restarted.SetStatePath(rs.statePath)
+	restarted.loadJobs()
+
+	if j := restarted.job(once.ID); j == nil || j.StartTime != once.StartTime {
+		t.Errorf("job %+v after restart, want start at %d", j, once.StartTime)
+	}
+
+	want := uint64(missed.AddDate(0, 0, 2).UnixNano())
+	if j := restarted.job(daily.ID); j == nil || j.StartTime != want {
+		t.Errorf("daily job %+v after restart, want the next run at %d", j, want)
+	}
+}
+
+func TestJobRuns(t *testing.T) {
+	tests := []struct {
+		name  string
+		daily bool
+	}{
+		{"once", false},
+		{"daily", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, cleanup := testServer(t)
+			defer cleanup()
+
+			start := time.Now().Add(-time.Second)
+			j, err := rs.createJob(testJob(start, 0.01, tt.daily))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rs.checkJobs()
+			rs.jobsWg.Wait()
+
+			done := rs.job(j.ID)
+			if !tt.daily {
+				if done != nil {
+					t.Errorf("job %+v kept after its run", done)
+				}
+				return
+			}
+
+			if done == nil {
+				t.Fatal("daily job removed after its run")
+			}
+			if done.Running || done.LastError != "" || done.LastRecording == "" {
+				t.Errorf("daily job %+v after its run, want a recording and no error", done)
+			}
+			if want := uint64(start.AddDate(0, 0, 1).UnixNano()); done.StartTime != want {
+				t.Errorf("daily job rescheduled at %d, want %d", done.StartTime, want)
+			}
+			if _, err := rs.loadRecording(done.LastRecording); err != nil {
+				t.Errorf("recording of the job: %s", err)
+			}
+		})
+	}
+}
+
+func TestCancelRunningJob(t *testing.T) {
+	rs, cleanup := testServer(t)
+	defer cleanup()
+
+	j, err := rs.createJob(testJob(time.Now().Add(-time.Second), 60, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs.checkJobs()
+	waitFor(t, "the job session", func() bool {
+		rs.sessionLock.Lock()
+		defer rs.sessionLock.Unlock()
+		return len(rs.sessions) == 1
+	})
+
+	if err := rs.cancelJob(j.ID); err != nil {
+		t.Fatal(err)
+	}
+	rs.jobsWg.Wait()
+
+	if rs.job(j.ID) != nil {
+		t.Error("cancelled job still scheduled")
+	}
+	if len(rs.sessions) != 0 {
+		t.Error("cancelled job didn't release its session")
+	}
+	if err := rs.cancelJob(j.ID); err == nil {
+		t.Error("cancelling a cancelled job should fail")
+	}
+
+	restarted := MakeRadioServer("test")
+	restarted.SetStatePath(rs.statePath)
+	restarted.loadJobs()
+	if restarted.job(j.ID) != nil {
+		t.Error("cancelled job restored after restart")
+	}
+}
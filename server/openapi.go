@@ -0,0 +1,195 @@
+package server
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// openAPI builds the OpenAPI description of the REST routes. The schemas are generated from the protocol messages
+// in their JSON mapping, where the 64 bit integers are strings.
+func (rs *RadioServer) openAPI(routes []restRoute) []byte {
+	schemas := openAPISchemas{
+		"Error": {
+			"type": "object",
+			"properties": map[string]interface{}{
+				"Error": map[string]interface{}{"type": "string"},
+			},
+		},
+	}
+
+	paths := map[string]map[string]interface{}{}
+	for _, route := range routes {
+		ok := openAPIContent(schemas.ref(route.response))
+		if route.stream != nil {
+			ok = map[string]interface{}{
+				"application/x-ndjson": ok["application/json"],
+			}
+		}
+
+		op := map[string]interface{}{
+			"summary": route.summary,
+			"responses": map[string]interface{}{
+				"200": map[string]interface{}{
+					"description": "OK",
+					"content":     ok,
+				},
+				"default": map[string]interface{}{
+					"description": "Error",
+					"content":     openAPIContent(map[string]interface{}{"$ref": "#/components/schemas/Error"}),
+				},
+			},
+		}
+
+		var params []interface{}
+		for _, s := range strings.Split(route.path, "/") {
+			if strings.HasPrefix(s, "{") {
+				params = append(params, map[string]interface{}{
+					"name":     s[1 : len(s)-1],
+					"in":       "path",
+					"required": true,
+					"schema":   map[string]interface{}{"type": "string"},
+				})
+			}
+		}
+		for _, name := range route.query {
+			params = append(params, map[string]interface{}{
+				"name":   name,
+				"in":     "query",
+				"schema": map[string]interface{}{"type": "number"},
+			})
+		}
+		if len(params) > 0 {
+			op["parameters"] = params
+		}
+
+		if route.body != nil {
+			op["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content":  openAPIContent(schemas.ref(route.body)),
+			}
+		}
+
+		path := restPrefix + route.path
+		if paths[path] == nil {
+			paths[path] = map[string]interface{}{}
+		}
+		paths[path][strings.ToLower(route.method)] = op
+	}
+
+	paths[restPrefix+"/openapi.json"] = map[string]interface{}{
+		"get": map[string]interface{}{
+			"summary": "This description",
+			"responses": map[string]interface{}{
+				"200": map[string]interface{}{"description": "OK"},
+			},
+		},
+	}
+
+	data, err := json.MarshalIndent(map[string]interface{}{
+		"openapi": "3.0.0",
+		"info": map[string]interface{}{
+			"title":   rs.serverInfo.Name,
+			"version": rs.serverInfo.Version.AsString(),
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+		},
+	}, "", "   ")
+	if err != nil {
+		log.Error("Error building the OpenAPI description: %s", err)
+	}
+
+	return data
+}
+
+func openAPIContent(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"application/json": map[string]interface{}{
+			"schema": schema,
+		},
+	}
+}
+
+// openAPISchemas are the schemas of the protocol messages, by name
+type openAPISchemas map[string]map[string]interface{}
+
+// ref adds the schema of msg and the messages it holds, returning a reference to it
+func (s openAPISchemas) ref(msg proto.Message) map[string]interface{} {
+	return s.message(reflect.TypeOf(msg).Elem())
+}
+
+func (s openAPISchemas) message(t reflect.Type) map[string]interface{} {
+	ref := map[string]interface{}{"$ref": "#/components/schemas/" + t.Name()}
+	if s[t.Name()] != nil {
+		return ref
+	}
+
+	properties := map[string]interface{}{}
+	s[t.Name()] = map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+
+	for _, prop := range proto.GetProperties(t).Prop {
+		if strings.HasPrefix(prop.Name, "XXX_") {
+			continue
+		}
+
+		f, _ := t.FieldByName(prop.Name)
+		name := prop.JSONName
+		if name == "" {
+			name = prop.OrigName
+		}
+		properties[name] = s.field(f.Type, prop.Enum)
+	}
+
+	return ref
+}
+
+func (s openAPISchemas) field(t reflect.Type, enum string) map[string]interface{} {
+	if t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 {
+		return map[string]interface{}{
+			"type":  "array",
+			"items": s.field(t.Elem(), enum),
+		}
+	}
+
+	if enum != "" {
+		values := proto.EnumValueMap(enum)
+		var names []string
+		for name := range values {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			return values[names[i]] < values[names[j]]
+		})
+		return map[string]interface{}{"type": "string", "enum": names}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return s.message(t.Elem())
+	case reflect.Slice:
+		return map[string]interface{}{"type": "string", "format": "byte"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int32:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Uint32:
+		return map[string]interface{}{"type": "integer", "format": "uint32"}
+	case reflect.Int64:
+		return map[string]interface{}{"type": "string", "format": "int64"}
+	case reflect.Uint64:
+		return map[string]interface{}{"type": "string", "format": "uint64"}
+	case reflect.Float32:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case reflect.Float64:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	}
+	return map[string]interface{}{"type": "string"}
+}
@@ -1,13 +1,16 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"sync"
 	"time"
 
   _ "google.golang.org/grpc/encoding/gzip"
 	"github.com/luigifreitas/radioserver"
+	"github.com/luigifreitas/radioserver/DSP"
 	"github.com/luigifreitas/radioserver/protocol"
 	"github.com/quan-to/slog"
 	"google.golang.org/grpc"
@@ -15,15 +18,45 @@ import (
 
 var log = slog.Scope("RadioServer")
 
+const (
+	defaultRecordingsPath = "recordings"
+	defaultStatePath      = "state"
+)
+
 type RadioServer struct {
 	serverInfo *protocol.ServerInfoData
 
 	sessions    map[string]*Session
 	sessionLock sync.Mutex
 	grpcServer  *grpc.Server
+	httpServer  *http.Server
+	// httpOrigins are the origins of the pages allowed to open a WebSocket, besides the server own
+	httpOrigins []string
 
 	running           bool
+	ctx               context.Context
+	stop              context.CancelFunc
 	lastSessionChecks time.Time
+	overflowPolicy    DSP.OverflowPolicy
+
+	recordingsPath      string
+	maxRecordingsSize   int64
+	maxRecordingsAge    time.Duration
+	lastRecordingChecks time.Time
+
+	statePath string
+	jobs      map[string]*protocol.Job
+	jobStop   map[string]chan bool
+	jobLock   sync.Mutex
+	jobsWg    sync.WaitGroup
+
+	// corrections are the frequency errors of the devices in ppm, by serial
+	corrections    map[string]float64
+	correctionLock sync.Mutex
+
+	// drivers and allowedDevices restrict the devices served, no restriction when empty
+	drivers        []string
+	allowedDevices []string
 }
 
 func MakeRadioServer(serverName string) *RadioServer {
@@ -36,29 +69,83 @@ func MakeRadioServer(serverName string) *RadioServer {
 				Hash:  radioserver.ServerVersion.Hash,
 			},
 		},
-		sessions:    map[string]*Session{},
-		sessionLock: sync.Mutex{},
+		sessions:       map[string]*Session{},
+		overflowPolicy: DSP.DropNewest,
+		sessionLock:    sync.Mutex{},
+		recordingsPath: defaultRecordingsPath,
+		statePath:      defaultStatePath,
+		jobs:           map[string]*protocol.Job{},
+		jobStop:        map[string]chan bool{},
+		jobLock:        sync.Mutex{},
+		corrections:    map[string]float64{},
 	}
 
+	rs.ctx, rs.stop = context.WithCancel(context.Background())
+
 	return rs
 }
 
-func (rs *RadioServer) Listen(address string) error {
+// SetOverflowPolicy sets what the sample pipeline of new sessions does when a consumer can't keep up.
+// Block only applies to the stream senders, the chain input drops the newest samples instead.
+func (rs *RadioServer) SetOverflowPolicy(policy DSP.OverflowPolicy) {
+	rs.overflowPolicy = policy
+}
+
+func (rs *RadioServer) isShuttingDown() bool {
+	return rs.ctx.Err() != nil
+}
+
+// SetRecordingsPath sets the folder where the SigMF recordings are stored
+func (rs *RadioServer) SetRecordingsPath(path string) {
+	rs.recordingsPath = path
+}
+
+// SetStatePath sets the folder where the server keeps its persistent state
+func (rs *RadioServer) SetStatePath(path string) {
+	rs.statePath = path
+}
+
+// SetRecordingsRetention sets the limits of the recordings storage.
+// The oldest recordings are deleted when the total size goes over maxBytes or when they are older than maxAge.
+// Zero disables the limit.
+func (rs *RadioServer) SetRecordingsRetention(maxBytes int64, maxAge time.Duration) {
+	rs.maxRecordingsSize = maxBytes
+	rs.maxRecordingsAge = maxAge
+}
+
+// Listen serves the RPC calls on every address
+func (rs *RadioServer) Listen(addresses ...string) error {
 	if rs.grpcServer != nil {
 		return fmt.Errorf("server already runing")
 	}
 
-	lis, err := net.Listen("tcp", address)
-	if err != nil {
-		return err
+	if len(addresses) == 0 {
+		return fmt.Errorf("no address to listen on")
+	}
+
+	var listeners []net.Listener
+	for _, address := range addresses {
+		lis, err := net.Listen("tcp", address)
+		if err != nil {
+			for _, l := range listeners {
+				_ = l.Close()
+			}
+			return err
+		}
+		log.Info("Listening on %s", lis.Addr())
+		listeners = append(listeners, lis)
 	}
 
 	rs.grpcServer = grpc.NewServer()
 
 	protocol.RegisterRadioServerServer(rs.grpcServer, rs)
+	rs.loadJobs()
+	rs.loadFrequencyCorrections()
 	rs.running = true
 	go rs.routines()
-	go rs.serve(lis)
+	for _, lis := range listeners {
+		go rs.serve(lis)
+	}
 	return nil
 }
 
@@ -67,15 +154,64 @@ func (rs *RadioServer) serve(conn net.Listener) {
 	if err != nil {
 		log.Error("RPC Error: %s", err)
 	}
-	rs.Stop()
+	if !rs.isShuttingDown() {
+		rs.Stop()
+	}
 }
 
+// Stop closes all connections immediately and releases every session
 func (rs *RadioServer) Stop() {
 	if rs.grpcServer == nil {
 		return
 	}
 	log.Info("Stopping RPC Server")
+	rs.stop()
+	rs.stopJobs()
+	rs.stopHTTP(0)
 	rs.grpcServer.Stop()
 	rs.grpcServer = nil
 	rs.running = false
+	rs.releaseSessions()
+}
+
+// GracefulStop stops accepting new sessions, notifies the streaming clients and waits up to timeout
+// for the pending calls to finish before closing the connections. Every session is released after that.
+func (rs *RadioServer) GracefulStop(timeout time.Duration) {
+	if rs.grpcServer == nil {
+		return
+	}
+	log.Info("Gracefully stopping RPC Server")
+	rs.stop()
+	rs.stopJobs()
+	rs.stopHTTP(timeout)
+
+	done := make(chan bool)
+	go func() {
+		rs.grpcServer.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		log.Warn("Timeout waiting for the calls to finish, closing connections")
+		rs.grpcServer.Stop()
+	}
+
+	rs.grpcServer = nil
+	rs.running = false
+	rs.releaseSessions()
+}
+
+// releaseSessions stops the frontend and channel generator of every session
+func (rs *RadioServer) releaseSessions() {
+	rs.sessionLock.Lock()
+	sessions := rs.sessions
+	rs.sessions = map[string]*Session{}
+	rs.sessionLock.Unlock()
+
+	for token, session := range sessions {
+		log.Info("Releasing %s", token)
+		session.FullStop()
+	}
 }
@@ -0,0 +1,206 @@
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	uuid2 "github.com/gofrs/uuid"
+	"github.com/luigifreitas/radioserver/protocol"
+	"github.com/luigifreitas/radioserver/sigmf"
+)
+
+type storedRecording struct {
+	info     *protocol.RecordingInfo
+	modified time.Time
+}
+
+func (rs *RadioServer) startRecording(s *Session, format protocol.SampleFormat, description string) (*protocol.Recording, error) {
+	if err := os.MkdirAll(rs.recordingsPath, 0755); err != nil {
+		return nil, err
+	}
+
+	u, _ := uuid2.NewV4()
+	ID := u.String()
+
+	rec, err := s.StartRecording(ID, filepath.Join(rs.recordingsPath, ID), format, description)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Started recording %s on %s", ID, s.ID)
+	return rec, nil
+}
+
+// recordingBasePath returns the base path of the recording files, refusing anything that is not a recording ID
+func (rs *RadioServer) recordingBasePath(id string) (string, error) {
+	if _, err := uuid2.FromString(id); err != nil {
+		return "", fmt.Errorf("invalid recording id")
+	}
+
+	return filepath.Join(rs.recordingsPath, id), nil
+}
+
+func (rs *RadioServer) activeRecordings() map[string]bool {
+	rs.sessionLock.Lock()
+	defer rs.sessionLock.Unlock()
+
+	active := map[string]bool{}
+	for _, s := range rs.sessions {
+		if id := s.RecordingID(); id != "" {
+			active[id] = true
+		}
+	}
+
+	return active
+}
+
+// loadRecordings scans the recordings folder, sorted from oldest to newest
+func (rs *RadioServer) loadRecordings() ([]storedRecording, error) {
+	files, err := ioutil.ReadDir(rs.recordingsPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	active := rs.activeRecordings()
+
+	var recordings []storedRecording
+	for _, f := range files {
+		if !strings.HasSuffix(f.Name(), sigmf.MetaExtension) {
+			continue
+		}
+
+		id := strings.TrimSuffix(f.Name(), sigmf.MetaExtension)
+		r, err := rs.loadRecording(id)
+		if err != nil {
+			log.Warn("Skipping recording %s: %s", id, err)
+			continue
+		}
+
+		r.info.Active = active[id]
+		recordings = append(recordings, r)
+	}
+
+	sort.Slice(recordings, func(i, j int) bool {
+		return recordings[i].modified.Before(recordings[j].modified)
+	})
+
+	return recordings, nil
+}
+
+func (rs *RadioServer) loadRecording(id string) (storedRecording, error) {
+	basePath, err := rs.recordingBasePath(id)
+	if err != nil {
+		return storedRecording{}, err
+	}
+
+	meta, err := ioutil.ReadFile(basePath + sigmf.MetaExtension)
+	if err != nil {
+		return storedRecording{}, err
+	}
+
+	r, err := sigmf.Open(basePath)
+	if err != nil {
+		return storedRecording{}, err
+	}
+	defer r.Close()
+
+	st, err := os.Stat(basePath + sigmf.DataExtension)
+	if err != nil {
+		return storedRecording{}, err
+	}
+
+	rec := &protocol.Recording{
+		ID:      id,
+		Format:  r.Format(),
+		Samples: r.Samples(),
+	}
+
+	if start, ok := recordingStart(r.Meta); ok {
+		rec.StartTime = uint64(start.UnixNano())
+		if r.Meta.Global.SampleRate > 0 {
+			rec.StopTime = rec.StartTime + uint64(float64(rec.Samples)/r.Meta.Global.SampleRate*1e9)
+		}
+	}
+
+	return storedRecording{
+		info: &protocol.RecordingInfo{
+			Recording: rec,
+			Size:      uint64(st.Size()) + uint64(len(meta)),
+			Metadata:  string(meta),
+		},
+		modified: st.ModTime(),
+	}, nil
+}
+
+func (rs *RadioServer) deleteRecording(id string) error {
+	basePath, err := rs.recordingBasePath(id)
+	if err != nil {
+		return err
+	}
+
+	if rs.activeRecordings()[id] {
+		return fmt.Errorf("recording in progress")
+	}
+
+	if err := os.Remove(basePath + sigmf.DataExtension); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return os.Remove(basePath + sigmf.MetaExtension)
+}
+
+// enforceRetention deletes the oldest finished recordings until the configured size and age limits are met
+func (rs *RadioServer) enforceRetention() {
+	if rs.maxRecordingsSize <= 0 && rs.maxRecordingsAge <= 0 {
+		return
+	}
+
+	recordings, err := rs.loadRecordings()
+	if err != nil {
+		log.Error("Error loading recordings: %s", err)
+		return
+	}
+
+	var totalSize uint64
+	for _, r := range recordings {
+		totalSize += r.info.Size
+	}
+
+	for _, r := range recordings {
+		if r.info.Active {
+			continue
+		}
+
+		tooOld := rs.maxRecordingsAge > 0 && time.Since(r.modified) > rs.maxRecordingsAge
+		tooBig := rs.maxRecordingsSize > 0 && totalSize > uint64(rs.maxRecordingsSize)
+		if !tooOld && !tooBig {
+			continue
+		}
+
+		id := r.info.Recording.ID
+		if err := rs.deleteRecording(id); err != nil {
+			log.Error("Error deleting recording %s: %s", id, err)
+			continue
+		}
+
+		totalSize -= r.info.Size
+		log.Info("Retention policy deleted recording %s", id)
+	}
+}
+
+func recordingStart(m *sigmf.Meta) (time.Time, bool) {
+	if len(m.Captures) == 0 {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, m.Captures[0].DateTime)
+	return t, err == nil
+}
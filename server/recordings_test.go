@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	uuid2 "github.com/gofrs/uuid"
+	"github.com/golang/protobuf/proto"
+	"github.com/luigifreitas/radioserver/protocol"
+	"github.com/luigifreitas/radioserver/sigmf"
+)
+
+// writeTestRecording stores a recording of n samples at sampleRate, last modified age ago
+func writeTestRecording(t *testing.T, rs *RadioServer, n int, sampleRate float64, age time.Duration) string {
+	if err := os.MkdirAll(rs.recordingsPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	u, _ := uuid2.NewV4()
+	id := u.String()
+	basePath := filepath.Join(rs.recordingsPath, id)
+
+	w, err := sigmf.Create(basePath, protocol.SampleFormat_CF32, sigmf.Global{SampleRate: sampleRate})
+	if err != nil {
+		t.Fatal(err)
+	}
+	samples := make([]complex64, n)
+	for i := range samples {
+		samples[i] = complex(float32(i)/float32(n), 0)
+	}
+	if err := w.Write(samples); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	modified := time.Now().Add(-age)
+	if err := os.Chtimes(basePath+sigmf.DataExtension, modified, modified); err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func TestRecordingCallsWithoutSession(t *testing.T) {
+	rs, cleanup := testServer(t)
+	defer cleanup()
+
+	if _, err := rs.StartRecording(context.Background(), &protocol.RecordingRequest{}); err == nil {
+		t.Error("StartRecording without session should fail")
+	}
+	if _, err := rs.StartRecording(context.Background(), &protocol.RecordingRequest{Session: &protocol.Session{Token: "nope"}}); err == nil {
+		t.Error("StartRecording on an unknown session should fail")
+	}
+	if _, err := rs.StopRecording(context.Background(), &protocol.Session{Token: "nope"}); err == nil {
+		t.Error("StopRecording on an unknown session should fail")
+	}
+}
+
+func TestRecording(t *testing.T) {
+	rs, cleanup := testServer(t)
+	defer cleanup()
+
+	s, f := provisionFake(t, rs, 48000)
+	sid := &protocol.Session{Token: s.ID}
+
+	rec, err := rs.StartRecording(context.Background(), &protocol.RecordingRequest{
+		Session: sid,
+		Format:  protocol.SampleFormat_CS16,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rs.StartRecording(context.Background(), &protocol.RecordingRequest{Session: sid}); err == nil {
+		t.Error("a second recording on the session should fail")
+	}
+
+	f.feed(1000)
+	f.feed(1000)
+	waitFor(t, "the samples to be recorded", func() bool {
+		s.recordLock.Lock()
+		defer s.recordLock.Unlock()
+		return s.recorder.Samples() == 2000
+	})
+
+	stopped, err := rs.StopRecording(context.Background(), sid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stopped.ID != rec.ID || stopped.Samples != 2000 {
+		t.Errorf("stopped recording %s with %d samples, want %s with 2000", stopped.ID, stopped.Samples, rec.ID)
+	}
+
+	basePath, _ := rs.recordingBasePath(rec.ID)
+	r, err := sigmf.Open(basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	if r.Meta.Global.SampleRate != 48000 || r.Samples() != 2000 {
+		t.Errorf("recording at %v with %d samples, want 48000 with 2000", r.Meta.Global.SampleRate, r.Samples())
+	}
+	if len(r.Meta.Captures) == 0 || r.Meta.Captures[0].Frequency != 100e6 {
+		t.Errorf("recording captures %+v, want one at 100 MHz", r.Meta.Captures)
+	}
+}
+
+func TestDownloadRecording(t *testing.T) {
+	rs, cleanup := testServer(t)
+	defer cleanup()
+
+	id := writeTestRecording(t, rs, 8000, 8000, 0)
+
+	tests := []struct {
+		name       string
+		slice      protocol.RecordingSlice
+		samples    int
+		sampleRate float32
+	}{
+		{"whole", protocol.RecordingSlice{}, 8000, 8000},
+		{"slice", protocol.RecordingSlice{StartOffset: 0.25, Duration: 0.5}, 4000, 8000},
+		{"decimated", protocol.RecordingSlice{Decimation: 4}, 2000, 2000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slice := tt.slice
+			slice.ID = id
+
+			samples := 0
+			err := rs.DownloadRecording(&slice, &restDownloadStream{
+				ctx: context.Background(),
+				send: func(msg proto.Message) error {
+					pb := msg.(*protocol.IQData)
+					if pb.SampleRate != tt.sampleRate {
+						t.Errorf("sample rate %v, want %v", pb.SampleRate, tt.sampleRate)
+					}
+					if pb.SampleIndex != uint64(samples) {
+						t.Errorf("sample index %d, want %d", pb.SampleIndex, samples)
+					}
+					samples += len(pb.Samples) / 2
+					return nil
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if samples != tt.samples {
+				t.Errorf("downloaded %d samples, want %d", samples, tt.samples)
+			}
+		})
+	}
+}
+
+func TestRetention(t *testing.T) {
+	tests := []struct {
+		name    string
+		maxSize int64
+		maxAge  time.Duration
+		kept    []int
+	}{
+		{"unlimited", 0, 0, []int{0, 1, 2}},
+		{"age", 0, time.Hour * 2, []int{1, 2}},
+		// Every recording takes 8 kB of samples and its metadata
+		{"size", 20000, 0, []int{1, 2}},
+		{"size and age", 10000, time.Hour * 2, []int{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, cleanup := testServer(t)
+			defer cleanup()
+
+			ids := []string{
+				writeTestRecording(t, rs, 1000, 1000, time.Hour*3),
+				writeTestRecording(t, rs, 1000, 1000, time.Hour*1),
+				writeTestRecording(t, rs, 1000, 1000, 0),
+			}
+
+			rs.SetRecordingsRetention(tt.maxSize, tt.maxAge)
+			rs.enforceRetention()
+
+			recordings, err := rs.loadRecordings()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var kept []string
+			for _, r := range recordings {
+				kept = append(kept, r.info.Recording.ID)
+			}
+			var want []string
+			for _, i := range tt.kept {
+				want = append(want, ids[i])
+			}
+			if len(kept) != len(want) {
+				t.Fatalf("kept %v, want %v", kept, want)
+			}
+			for i := range kept {
+				if kept[i] != want[i] {
+					t.Errorf("kept %v, want %v", kept, want)
+					break
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,433 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/luigifreitas/radioserver/protocol"
+	"google.golang.org/grpc"
+)
+
+// restPrefix is the path of the REST API
+const restPrefix = "/api"
+
+// restMaxBodySize is the largest request body accepted by the REST API
+const restMaxBodySize = 1 << 20
+
+var restMarshaler = jsonpb.Marshaler{EmitDefaults: true}
+
+// restParams are the values of the path parameters of a request
+type restParams map[string]string
+
+func (p restParams) session() *protocol.Session {
+	return &protocol.Session{Token: p["session"]}
+}
+
+// restRoute maps an HTTP method and path onto a call of the server.
+// The path parameters are written between braces, like {session}.
+type restRoute struct {
+	method  string
+	path    string
+	summary string
+
+	// body is the message read from the request body, none when nil. response is the message answered.
+	body     proto.Message
+	response proto.Message
+
+	call func(ctx context.Context, p restParams, body proto.Message) (proto.Message, error)
+
+	// stream answers a stream of response messages instead of call, as newline delimited JSON.
+	// query are the names of the query parameters it reads.
+	stream func(r *http.Request, p restParams, send func(proto.Message) error) error
+	query  []string
+}
+
+// restRoutes are the calls of the REST API, mapped onto the RPC calls with the same messages
+func (rs *RadioServer) restRoutes() []restRoute {
+	empty := &protocol.Empty{}
+
+	return []restRoute{
+		{
+			method:   http.MethodGet,
+			path:     "/info",
+			summary:  "Server name and version",
+			response: &protocol.ServerInfoData{},
+			call: func(ctx context.Context, p restParams, _ proto.Message) (proto.Message, error) {
+				return rs.ServerInfo(ctx, empty)
+			},
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/devices",
+			summary:  "List the devices served",
+			response: &protocol.DeviceList{},
+			call: func(ctx context.Context, p restParams, _ proto.Message) (proto.Message, error) {
+				return rs.List(ctx, empty)
+			},
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/sessions",
+			summary:  "Open a device in a new session",
+			body:     &protocol.DeviceState{},
+			response: &protocol.Session{},
+			call: func(ctx context.Context, p restParams, body proto.Message) (proto.Message, error) {
+				return rs.Provision(ctx, body.(*protocol.DeviceState))
+			},
+		},
+		{
+			method:   http.MethodDelete,
+			path:     "/sessions/{session}",
+			summary:  "Close a session and its device",
+			response: &protocol.Empty{},
+			call: func(ctx context.Context, p restParams, _ proto.Message) (proto.Message, error) {
+				return rs.Destroy(ctx, p.session())
+			},
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/sessions/{session}/config",
+			summary:  "Configuration applied to the device of a session",
+			response: &protocol.DeviceConfig{},
+			call: func(ctx context.Context, p restParams, _ proto.Message) (proto.Message, error) {
+				return rs.GetDeviceConfig(ctx, p.session())
+			},
+		},
+		{
+			method:   http.MethodPut,
+			path:     "/sessions/{session}/config",
+			summary:  "Tune the device of a session, answering the configuration applied",
+			body:     &protocol.DeviceConfig{},
+			response: &protocol.DeviceConfig{},
+			call: func(ctx context.Context, p restParams, body proto.Message) (proto.Message, error) {
+				return rs.Tune(ctx, &protocol.DeviceTune{
+					Session: p.session(),
+					Config:  body.(*protocol.DeviceConfig),
+				})
+			},
+		},
+		{
+			method:   http.MethodPut,
+			path:     "/sessions/{session}/chain",
+			summary:  "Replace the processing chain of a session, answering the rates of its outputs",
+			body:     &protocol.ProcessingChain{},
+			response: &protocol.ProcessingChain{},
+			call: func(ctx context.Context, p restParams, body proto.Message) (proto.Message, error) {
+				chain := body.(*protocol.ProcessingChain)
+				chain.Session = p.session()
+				return rs.ConfigureChain(ctx, chain)
+			},
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/sessions/{session}/iq-correction",
+			summary:  "IQ imbalance correction of the channels of a session",
+			response: &protocol.IQCorrectionList{},
+			call: func(ctx context.Context, p restParams, _ proto.Message) (proto.Message, error) {
+				return rs.GetIQCorrection(ctx, p.session())
+			},
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/sessions/{session}/recording",
+			summary:  "Start recording the IQ samples of a session",
+			body:     &protocol.RecordingRequest{},
+			response: &protocol.Recording{},
+			call: func(ctx context.Context, p restParams, body proto.Message) (proto.Message, error) {
+				r := body.(*protocol.RecordingRequest)
+				r.Session = p.session()
+				return rs.StartRecording(ctx, r)
+			},
+		},
+		{
+			method:   http.MethodDelete,
+			path:     "/sessions/{session}/recording",
+			summary:  "Stop the recording of a session",
+			response: &protocol.Recording{},
+			call: func(ctx context.Context, p restParams, _ proto.Message) (proto.Message, error) {
+				return rs.StopRecording(ctx, p.session())
+			},
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/recordings",
+			summary:  "List the recordings",
+			response: &protocol.RecordingList{},
+			call: func(ctx context.Context, p restParams, _ proto.Message) (proto.Message, error) {
+				return rs.ListRecordings(ctx, empty)
+			},
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/recordings/{id}/samples",
+			summary:  "Download the IQ samples of a recording, from StartOffset for Duration seconds, decimated by Decimation",
+			response: &protocol.IQData{},
+			query:    []string{"StartOffset", "Duration", "Decimation"},
+			stream: func(r *http.Request, p restParams, send func(proto.Message) error) error {
+				slice, err := restRecordingSlice(p["id"], r.URL.Query())
+				if err != nil {
+					return err
+				}
+				return rs.DownloadRecording(slice, &restDownloadStream{ctx: r.Context(), send: send})
+			},
+		},
+		{
+			method:   http.MethodDelete,
+			path:     "/recordings/{id}",
+			summary:  "Delete a recording",
+			response: &protocol.Empty{},
+			call: func(ctx context.Context, p restParams, _ proto.Message) (proto.Message, error) {
+				return rs.DeleteRecording(ctx, &protocol.Recording{ID: p["id"]})
+			},
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/jobs",
+			summary:  "List the scheduled recordings",
+			response: &protocol.JobList{},
+			call: func(ctx context.Context, p restParams, _ proto.Message) (proto.Message, error) {
+				return rs.ListJobs(ctx, empty)
+			},
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/jobs",
+			summary:  "Schedule a recording",
+			body:     &protocol.Job{},
+			response: &protocol.Job{},
+			call: func(ctx context.Context, p restParams, body proto.Message) (proto.Message, error) {
+				return rs.CreateJob(ctx, body.(*protocol.Job))
+			},
+		},
+		{
+			method:   http.MethodDelete,
+			path:     "/jobs/{id}",
+			summary:  "Cancel a scheduled recording",
+			response: &protocol.Empty{},
+			call: func(ctx context.Context, p restParams, _ proto.Message) (proto.Message, error) {
+				return rs.CancelJob(ctx, &protocol.Job{ID: p["id"]})
+			},
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/frequency-corrections",
+			summary:  "List the frequency corrections of the devices",
+			response: &protocol.FrequencyCorrectionList{},
+			call: func(ctx context.Context, p restParams, _ proto.Message) (proto.Message, error) {
+				return rs.ListFrequencyCorrections(ctx, empty)
+			},
+		},
+		{
+			method:   http.MethodPut,
+			path:     "/frequency-corrections/{serial}",
+			summary:  "Set the frequency correction of a device",
+			body:     &protocol.FrequencyCorrection{},
+			response: &protocol.FrequencyCorrection{},
+			call: func(ctx context.Context, p restParams, body proto.Message) (proto.Message, error) {
+				c := body.(*protocol.FrequencyCorrection)
+				c.Serial = p["serial"]
+				return rs.SetFrequencyCorrection(ctx, c)
+			},
+		},
+	}
+}
+
+// restHandler serves the REST API and its OpenAPI description
+func (rs *RadioServer) restHandler() http.Handler {
+	routes := rs.restRoutes()
+	openAPI := rs.openAPI(routes)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, restPrefix)
+
+		if path == "/openapi.json" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(openAPI)
+			return
+		}
+
+		var allowed []string
+		for _, route := range routes {
+			p, ok := matchRestPath(route.path, path)
+			if !ok {
+				continue
+			}
+			if route.method != r.Method {
+				allowed = append(allowed, route.method)
+				continue
+			}
+
+			rs.serveRest(w, r, route, p)
+			return
+		}
+
+		if len(allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			writeRestError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		writeRestError(w, http.StatusNotFound, "not found")
+	})
+}
+
+func (rs *RadioServer) serveRest(w http.ResponseWriter, r *http.Request, route restRoute, p restParams) {
+	if token, ok := p["session"]; ok {
+		rs.sessionLock.Lock()
+		s := rs.sessions[token]
+		rs.sessionLock.Unlock()
+
+		if s == nil {
+			writeRestError(w, http.StatusNotFound, "session doesn't exist")
+			return
+		}
+	}
+
+	if route.stream != nil {
+		rs.serveRestStream(w, r, route, p)
+		return
+	}
+
+	var body proto.Message
+	if route.body != nil {
+		if t, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); t != "application/json" {
+			writeRestError(w, http.StatusUnsupportedMediaType, "content type should be application/json")
+			return
+		}
+
+		body = reflect.New(reflect.TypeOf(route.body).Elem()).Interface().(proto.Message)
+
+		err := jsonpb.Unmarshal(io.LimitReader(r.Body, restMaxBodySize), body)
+		if err != nil && err != io.EOF {
+			writeRestError(w, http.StatusBadRequest, "invalid body: "+err.Error())
+			return
+		}
+	}
+
+	res, err := route.call(r.Context(), p, body)
+	if err != nil {
+		// The calls report the invalid requests and the device failures alike
+		log.Warn("%s %s: %s", r.Method, r.URL.Path, err)
+		writeRestError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := restMarshaler.Marshal(w, res); err != nil {
+		log.Error("Error answering %s %s: %s", r.Method, r.URL.Path, err)
+	}
+}
+
+// serveRestStream answers the messages of a streaming route, one JSON object per line.
+// A failure before the first message is answered as an error, after it the response is cut short.
+func (rs *RadioServer) serveRestStream(w http.ResponseWriter, r *http.Request, route restRoute, p restParams) {
+	flusher, _ := w.(http.Flusher)
+	started := false
+
+	err := route.stream(r, p, func(msg proto.Message) error {
+		if !started {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			started = true
+		}
+		if err := restMarshaler.Marshal(w, msg); err != nil {
+			return err
+		}
+		if _, err := w.Write([]byte("\n")); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+
+	if err != nil {
+		log.Warn("%s %s: %s", r.Method, r.URL.Path, err)
+		if !started {
+			writeRestError(w, http.StatusBadRequest, err.Error())
+		}
+	}
+}
+
+// restDownloadStream hands the samples sent by DownloadRecording to a REST response
+type restDownloadStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	send func(proto.Message) error
+}
+
+func (s *restDownloadStream) Send(pb *protocol.IQData) error {
+	return s.send(pb)
+}
+
+func (s *restDownloadStream) Context() context.Context {
+	return s.ctx
+}
+
+// restRecordingSlice reads the part of recording id to download from the query parameters
+func restRecordingSlice(id string, q url.Values) (*protocol.RecordingSlice, error) {
+	slice := &protocol.RecordingSlice{ID: id}
+
+	for name, v := range map[string]*float64{"StartOffset": &slice.StartOffset, "Duration": &slice.Duration} {
+		if s := q.Get(name); s != "" {
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil || f < 0 {
+				return nil, fmt.Errorf("invalid %s", name)
+			}
+			*v = f
+		}
+	}
+
+	if s := q.Get("Decimation"); s != "" {
+		d, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Decimation")
+		}
+		slice.Decimation = uint32(d)
+	}
+
+	return slice, nil
+}
+
+// matchRestPath reports whether path matches the route pattern, returning the values of its parameters
+func matchRestPath(pattern, path string) (restParams, bool) {
+	ps := strings.Split(strings.Trim(pattern, "/"), "/")
+	vs := strings.Split(strings.Trim(path, "/"), "/")
+	if len(ps) != len(vs) {
+		return nil, false
+	}
+
+	p := restParams{}
+	for i, s := range ps {
+		if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+			if vs[i] == "" {
+				return nil, false
+			}
+			p[s[1:len(s)-1]] = vs[i]
+		} else if s != vs[i] {
+			return nil, false
+		}
+	}
+
+	return p, true
+}
+
+// restError is the body of the failed requests
+type restError struct {
+	Error string
+}
+
+func writeRestError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(restError{Error: message})
+}
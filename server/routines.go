@@ -3,8 +3,9 @@ package server
 import "time"
 
 const (
-	routinesInterval     = time.Second * 2
-	sessionCheckInterval = time.Second * 10
+	routinesInterval       = time.Second * 2
+	sessionCheckInterval   = time.Second * 10
+	recordingCheckInterval = time.Minute
 )
 
 func (rs *RadioServer) routines() {
@@ -12,7 +13,13 @@ func (rs *RadioServer) routines() {
 	rs.lastSessionChecks = time.Now()
 	for rs.running {
 		rs.checkSessions()
-		time.Sleep(routinesInterval)
+		rs.checkRecordings()
+		rs.checkJobs()
+
+		select {
+		case <-time.After(routinesInterval):
+		case <-rs.ctx.Done():
+		}
 	}
 	log.Warn("RadioServer Routines Stopped")
 }
@@ -33,3 +40,12 @@ func (rs *RadioServer) checkSessions() {
 	}
 	rs.lastSessionChecks = time.Now()
 }
+
+func (rs *RadioServer) checkRecordings() {
+	if time.Since(rs.lastRecordingChecks) < recordingCheckInterval {
+		return
+	}
+
+	rs.enforceRetention()
+	rs.lastRecordingChecks = time.Now()
+}
@@ -3,81 +3,309 @@ package server
 import (
 	"context"
 	"fmt"
-	"runtime"
 	"sync"
 	"time"
-	"github.com/luigifreitas/radioserver/frontends"
 	"github.com/luigifreitas/radioserver/protocol"
+	"github.com/luigifreitas/radioserver/sigmf"
+	"github.com/racerxdl/segdsp/dsp"
 )
 
+const downloadChunkSize = 16384
+
 // region GRPC Stuff
 
 func (rs *RadioServer) List(ctx context.Context, s *protocol.Empty) (*protocol.DeviceList, error) {
-	var dl protocol.DeviceList
+	return rs.devices(), nil
+}
 
-	for _, finder := range frontends.FindDevices {
-		finder(&dl)
+func (rs *RadioServer) Provision(ctx context.Context, d *protocol.DeviceState) (*protocol.Session, error) {
+	s, err := rs.provision(d)
+	if err != nil {
+		return nil, err
 	}
 
-	return &dl, nil
+	return &protocol.Session{
+		Token: s.ID,
+	}, nil
 }
 
-func (rs *RadioServer) Provision(ctx context.Context, d *protocol.DeviceState) (*protocol.Session, error) {
+// provision opens the device of d in a new session
+func (rs *RadioServer) provision(d *protocol.DeviceState) (*Session, error) {
+	if rs.isShuttingDown() {
+		return nil, fmt.Errorf("server is shutting down")
+	}
+
+	if err := rs.checkDevice(d); err != nil {
+		return nil, err
+	}
+
 	rs.sessionLock.Lock()
 	defer rs.sessionLock.Unlock()
 
-	s := GenerateSession(d)
-	if s == nil {
-		return nil, fmt.Errorf("error provisioning")
+	s, err := GenerateSession(d, rs.overflowPolicy, rs.frequencyCorrection(d))
+	if err != nil {
+		return nil, err
 	}
 
-  rs.sessions[s.ID] = s
+	rs.sessions[s.ID] = s
 	log.Info("Provisioned %s!", s.ID)
 
-	return &protocol.Session{
-		Token: s.ID,
-	}, nil
+	return s, nil
 }
 
 func (rs *RadioServer) Destroy(ctx context.Context, sid *protocol.Session) (*protocol.Empty, error) {
+	if err := rs.destroy(sid.Token); err != nil {
+		return nil, err
+	}
+	return &protocol.Empty{}, nil
+}
+
+// destroy stops the session of token and forgets it
+func (rs *RadioServer) destroy(token string) error {
 	rs.sessionLock.Lock()
 	defer rs.sessionLock.Unlock()
 
-	s := rs.sessions[sid.Token]
+	s := rs.sessions[token]
 	if s == nil {
-		return nil, fmt.Errorf("session doesn't exist")
+		return fmt.Errorf("session doesn't exist")
 	}
 
-	delete(rs.sessions, sid.Token)
+	delete(rs.sessions, token)
 	s.FullStop()
 
 	log.Info("Destroyed %s!", s.ID)
-	return nil, nil
+	return nil
 }
 
 func (rs *RadioServer) ServerInfo(context.Context, *protocol.Empty) (*protocol.ServerInfoData, error) {
 	return rs.serverInfo, nil
 }
 
+// Tune applies a device configuration to a session and returns the configuration actually applied
 func (rs *RadioServer) Tune(ctx context.Context, dt *protocol.DeviceTune) (*protocol.DeviceConfig, error) {
-  s := rs.sessions[dt.Session.Token]
+	if dt.Session == nil {
+		return nil, fmt.Errorf("session doesn't exist")
+	}
+
+	rs.sessionLock.Lock()
+	s := rs.sessions[dt.Session.Token]
+	rs.sessionLock.Unlock()
+
+	if s == nil {
+		return nil, fmt.Errorf("session doesn't exist")
+	}
+
+	if dt.Config == nil {
+		return nil, fmt.Errorf("no device configuration")
+	}
+
+	if err := s.TuneFrontend(dt.Config); err != nil {
+		return nil, err
+	}
+	s.KeepAlive()
+
+	applied := s.DeviceConfig()
+	return &applied, nil
+}
+
+// GetDeviceConfig returns the device configuration of a session
+func (rs *RadioServer) GetDeviceConfig(ctx context.Context, sid *protocol.Session) (*protocol.DeviceConfig, error) {
+	rs.sessionLock.Lock()
+	s := rs.sessions[sid.Token]
+	rs.sessionLock.Unlock()
+
+	if s == nil {
+		return nil, fmt.Errorf("session doesn't exist")
+	}
+	s.KeepAlive()
+
+	c := s.DeviceConfig()
+	return &c, nil
+}
+
+// ConfigureChain replaces the processing chain of a session and returns the rate of each output
+func (rs *RadioServer) ConfigureChain(ctx context.Context, c *protocol.ProcessingChain) (*protocol.ProcessingChain, error) {
+	if c.Session == nil {
+		return nil, fmt.Errorf("session doesn't exist")
+	}
+
+	rs.sessionLock.Lock()
+	s := rs.sessions[c.Session.Token]
+	rs.sessionLock.Unlock()
+
 	if s == nil {
 		return nil, fmt.Errorf("session doesn't exist")
 	}
 
-  s.TuneFrontend(dt.Config)
-  return dt.Config, nil
+	if err := s.ConfigureChain(c.Blocks); err != nil {
+		return nil, err
+	}
+	s.KeepAlive()
+
+	return &protocol.ProcessingChain{
+		Session:   c.Session,
+		Blocks:    s.CG.ChainConfigs(),
+		SinkRates: s.SinkRates(),
+	}, nil
+}
+
+// GetIQCorrection returns the DC and IQ imbalance corrections applied to a session
+func (rs *RadioServer) GetIQCorrection(ctx context.Context, sid *protocol.Session) (*protocol.IQCorrectionList, error) {
+	rs.sessionLock.Lock()
+	s := rs.sessions[sid.Token]
+	rs.sessionLock.Unlock()
+
+	if s == nil {
+		return nil, fmt.Errorf("session doesn't exist")
+	}
+
+	return s.IQCorrection(), nil
+}
+
+// Calibrate runs the hardware calibration of a session channel
+func (rs *RadioServer) Calibrate(ctx context.Context, c *protocol.CalibrationRequest) (*protocol.IQCorrectionList, error) {
+	if c.Session == nil {
+		return nil, fmt.Errorf("session doesn't exist")
+	}
+
+	rs.sessionLock.Lock()
+	s := rs.sessions[c.Session.Token]
+	rs.sessionLock.Unlock()
+
+	if s == nil {
+		return nil, fmt.Errorf("session doesn't exist")
+	}
+
+	if err := s.Calibrate(c.Channel, float64(c.Bandwidth)); err != nil {
+		return nil, err
+	}
+	s.KeepAlive()
+
+	log.Info("Calibrated channel %d of %s", c.Channel, s.ID)
+	return s.IQCorrection(), nil
+}
+
+// SetFrequencyCorrection saves the frequency error of a device, applying it to its sessions
+func (rs *RadioServer) SetFrequencyCorrection(ctx context.Context, c *protocol.FrequencyCorrection) (*protocol.FrequencyCorrection, error) {
+	if err := rs.setFrequencyCorrection(c.Serial, c.PPM); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (rs *RadioServer) ListFrequencyCorrections(ctx context.Context, e *protocol.Empty) (*protocol.FrequencyCorrectionList, error) {
+	return rs.listFrequencyCorrections(), nil
+}
+
+// EstimateFrequencyCorrection measures the frequency error of a session device from a known carrier
+func (rs *RadioServer) EstimateFrequencyCorrection(ctx context.Context, r *protocol.PPMEstimateRequest) (*protocol.PPMEstimate, error) {
+	if r.Session == nil {
+		return nil, fmt.Errorf("session doesn't exist")
+	}
+
+	rs.sessionLock.Lock()
+	s := rs.sessions[r.Session.Token]
+	rs.sessionLock.Unlock()
+
+	if s == nil {
+		return nil, fmt.Errorf("session doesn't exist")
+	}
+
+	e, err := s.estimatePPM(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	s.KeepAlive()
+
+	log.Info("Estimated %v ppm for %s (%.1f dB)", e.Correction.PPM, e.Correction.Serial, e.SNR)
+
+	if r.Apply {
+		if err := rs.setFrequencyCorrection(e.Correction.Serial, e.Correction.PPM); err != nil {
+			return nil, err
+		}
+	}
+
+	return e, nil
+}
+
+// Scan steps a session device across frequency ranges, streaming the peaks found at each step
+func (rs *RadioServer) Scan(r *protocol.ScanRequest, server protocol.RadioServer_ScanServer) error {
+	if r.Session == nil {
+		return fmt.Errorf("session doesn't exist")
+	}
+
+	rs.sessionLock.Lock()
+	s := rs.sessions[r.Session.Token]
+	rs.sessionLock.Unlock()
+
+	if s == nil {
+		return fmt.Errorf("session doesn't exist")
+	}
+
+	ctx, cancel := rs.callContext(server.Context())
+	defer cancel()
+
+	log.Info("Scanning %d ranges on %s", len(r.Ranges), s.ID)
+	return s.scan(ctx, r, server.Send)
+}
+
+// Sweep retunes a session device across a frequency range, streaming a stitched spectrum every sweep
+func (rs *RadioServer) Sweep(r *protocol.SweepRequest, server protocol.RadioServer_SweepServer) error {
+	if r.Session == nil {
+		return fmt.Errorf("session doesn't exist")
+	}
+
+	rs.sessionLock.Lock()
+	s := rs.sessions[r.Session.Token]
+	rs.sessionLock.Unlock()
+
+	if s == nil {
+		return fmt.Errorf("session doesn't exist")
+	}
+
+	ctx, cancel := rs.callContext(server.Context())
+	defer cancel()
+
+	log.Info("Sweeping %v to %v Hz on %s", r.StartFrequency, r.StopFrequency, s.ID)
+	return s.sweep(ctx, r, server.Send)
+}
+
+// callContext returns a context of a call that is also cancelled when the server shuts down
+func (rs *RadioServer) callContext(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	go func() {
+		select {
+		case <-rs.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
 }
 
 func (rs *RadioServer) RXIQ(sid *protocol.Session, server protocol.RadioServer_RXIQServer) error {
+	rs.sessionLock.Lock()
 	s := rs.sessions[sid.Token]
-	if s.CG.IQRunning() {
-		return fmt.Errorf("already running")
+	rs.sessionLock.Unlock()
+
+	if s == nil {
+		return fmt.Errorf("session doesn't exist")
+	}
+	if err := s.Attach(); err != nil {
+		return err
 	}
 
-	s.CG.StartIQ()
-  defer delete(rs.sessions, sid.Token)
-	defer s.FullStop()
+	if err := s.StartStreaming(); err != nil {
+		s.Detach()
+		return err
+	}
+
+	defer func() {
+		s.FullStop()
+		rs.sessionLock.Lock()
+		delete(rs.sessions, sid.Token)
+		rs.sessionLock.Unlock()
+	}()
 
 	lastNumSamples := 0
 	pool := sync.Pool{
@@ -86,30 +314,200 @@ func (rs *RadioServer) RXIQ(sid *protocol.Session, server protocol.RadioServer_R
 		},
 	}
 
+	var counters streamCounters
 	for {
-		for s.IQFifo.Len() > 0 {
-			samples := s.IQFifo.Next().([]complex64)
-			pb := protocol.MakeIQDataWithPool(samples, pool)
+		select {
+		case item := <-s.IQQueue.Items():
+			buff := item.(*IQBuffer)
+			pooled := buff.Kind == protocol.SampleKind_IQSamples && buff.Squelch == nil
+
+			var pb *protocol.IQData
+			if pooled {
+				pb = protocol.MakeIQDataWithPool(buff.Complex, pool)
+			} else {
+				pb = makeIQData(buff)
+			}
+			counters.fill(buff, pb)
 			if err := server.Send(pb); err != nil {
 				log.Error("Error sending samples to %s: %s", s.ID, err)
 				return err
 			}
 			s.KeepAlive()
 
+			if !pooled {
+				continue
+			}
+
 			if len(pb.Samples) != lastNumSamples {
 				lastNumSamples = len(pb.Samples)
 			}
 
 			pool.Put(pb.Samples) // If the size is not correct, MakeIQDataWithPool will discard or trim it
+		case <-s.Done():
+			log.Error("Session Expired")
+			return fmt.Errorf("session expired")
+		case <-server.Context().Done():
+			return server.Context().Err()
+		case <-rs.ctx.Done():
+			log.Info("Server shutting down, closing stream of %s", s.ID)
+			return server.Send(&protocol.IQData{
+				Timestamp: uint64(time.Now().UnixNano()),
+				Status:    protocol.StatusType_Error,
+				Error:     "server shutting down",
+			})
+		}
+	}
+}
 
-			if s.IsFullStopped() {
-				log.Error("Session Expired")
-				return fmt.Errorf("session expired")
-			}
-			runtime.Gosched()
+func (rs *RadioServer) StartRecording(ctx context.Context, r *protocol.RecordingRequest) (*protocol.Recording, error) {
+	if r.Session == nil {
+		return nil, fmt.Errorf("session doesn't exist")
+	}
+
+	rs.sessionLock.Lock()
+	s := rs.sessions[r.Session.Token]
+	rs.sessionLock.Unlock()
+
+	if s == nil {
+		return nil, fmt.Errorf("session doesn't exist")
+	}
+
+	return rs.startRecording(s, r.Format, r.Description)
+}
+
+func (rs *RadioServer) StopRecording(ctx context.Context, sid *protocol.Session) (*protocol.Recording, error) {
+	rs.sessionLock.Lock()
+	s := rs.sessions[sid.Token]
+	rs.sessionLock.Unlock()
+
+	if s == nil {
+		return nil, fmt.Errorf("session doesn't exist")
+	}
+
+	rec, err := s.StopRecording()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Stopped recording %s on %s (%d samples)", rec.ID, s.ID, rec.Samples)
+	return rec, nil
+}
+
+func (rs *RadioServer) ListRecordings(ctx context.Context, e *protocol.Empty) (*protocol.RecordingList, error) {
+	recordings, err := rs.loadRecordings()
+	if err != nil {
+		return nil, err
+	}
+
+	var rl protocol.RecordingList
+	for _, r := range recordings {
+		rl.Recordings = append(rl.Recordings, r.info)
+	}
+
+	return &rl, nil
+}
+
+func (rs *RadioServer) DownloadRecording(slice *protocol.RecordingSlice, server protocol.RadioServer_DownloadRecordingServer) error {
+	basePath, err := rs.recordingBasePath(slice.ID)
+	if err != nil {
+		return err
+	}
+
+	r, err := sigmf.Open(basePath)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	sampleRate := r.Meta.Global.SampleRate
+	if sampleRate <= 0 {
+		return fmt.Errorf("recording has no sample rate")
+	}
+
+	offset := uint64(slice.StartOffset * sampleRate)
+	end := r.Samples()
+	if slice.Duration > 0 {
+		if e := offset + uint64(slice.Duration*sampleRate); e < end {
+			end = e
 		}
-		time.Sleep(time.Millisecond)
 	}
+
+	var decimator *dsp.Decimator
+	decimation := int(slice.Decimation)
+	if decimation > 1 {
+		decimator = dsp.MakeDecimator(decimation)
+	} else {
+		decimation = 1
+	}
+
+	var startTime uint64
+	if t, ok := recordingStart(r.Meta); ok {
+		startTime = uint64(t.UnixNano())
+	}
+
+	chunkSize := downloadChunkSize - downloadChunkSize%decimation
+	var sampleIndex uint64
+	for offset < end {
+		n := chunkSize
+		if remaining := end - offset; uint64(n) > remaining {
+			n = int(remaining)
+		}
+
+		samples, err := r.ReadSamples(offset, n)
+		if err != nil {
+			return err
+		}
+
+		if decimator != nil {
+			samples = decimator.Work(samples)
+		}
+
+		pb := protocol.MakeIQData(samples)
+		pb.Timestamp = startTime + uint64(float64(offset)/sampleRate*1e9)
+		pb.SampleRate = float32(sampleRate / float64(decimation))
+		pb.SampleIndex = sampleIndex
+		sampleIndex += uint64(len(samples))
+		if err := server.Send(pb); err != nil {
+			log.Error("Error sending recording %s: %s", slice.ID, err)
+			return err
+		}
+
+		offset += uint64(n)
+	}
+
+	return nil
+}
+
+func (rs *RadioServer) DeleteRecording(ctx context.Context, rec *protocol.Recording) (*protocol.Empty, error) {
+	if err := rs.deleteRecording(rec.ID); err != nil {
+		return nil, err
+	}
+
+	log.Info("Deleted recording %s", rec.ID)
+	return &protocol.Empty{}, nil
+}
+
+func (rs *RadioServer) CreateJob(ctx context.Context, j *protocol.Job) (*protocol.Job, error) {
+	j, err := rs.createJob(j)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Created job %s", j.ID)
+	return j, nil
+}
+
+func (rs *RadioServer) ListJobs(ctx context.Context, e *protocol.Empty) (*protocol.JobList, error) {
+	return rs.listJobs(), nil
+}
+
+func (rs *RadioServer) CancelJob(ctx context.Context, j *protocol.Job) (*protocol.Empty, error) {
+	if err := rs.cancelJob(j.ID); err != nil {
+		return nil, err
+	}
+
+	log.Info("Cancelled job %s", j.ID)
+	return &protocol.Empty{}, nil
 }
 
 // endregion
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+func TestTuneReportsAppliedSampleRate(t *testing.T) {
+	rs, cleanup := testServer(t)
+	defer cleanup()
+
+	d := testDevice(96000)
+	d.Chain = []*protocol.BlockConfig{
+		{Type: protocol.BlockType_TranslatorBlock, Frequency: 40000, Bandwidth: 10000},
+	}
+	s, err := rs.provision(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		sampleRate float32
+		want       float32
+		fails      bool
+	}{
+		// The fake device rounds the rate to 1 kHz
+		{"rounded", 123456, 123000, false},
+		{"unchanged", 0, 123000, false},
+		{"exact", 200000, 200000, false},
+		// The translator is out of the band of 48 kS/s
+		{"chain doesn't fit", 48000, 200000, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testDevice(tt.sampleRate).Config
+			applied, err := rs.Tune(context.Background(), &protocol.DeviceTune{
+				Session: &protocol.Session{Token: s.ID},
+				Config:  c,
+			})
+			if tt.fails != (err != nil) {
+				t.Fatalf("error %v, want failure %v", err, tt.fails)
+			}
+			if err == nil && applied.SampleRate != tt.want {
+				t.Errorf("tune answered %v, want %v", applied.SampleRate, tt.want)
+			}
+
+			if c := s.DeviceConfig(); c.SampleRate != tt.want {
+				t.Errorf("device at %v, want %v", c.SampleRate, tt.want)
+			}
+			if rates := s.SinkRates(); rates[0] != tt.want {
+				t.Errorf("chain output at %v, want %v", rates[0], tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"sync"
+	"time"
+)
+
+// sampleClock maps the device sample counter to wall clock time.
+// It is anchored when the first samples arrive from the frontend, before any queueing,
+// so the timestamps derived from it follow the device clock instead of the send time.
+type sampleClock struct {
+	sync.Mutex
+
+	sampleRate  float64
+	anchored    bool
+	anchorIndex uint64
+	anchorTime  time.Time
+	lastIndex   uint64
+}
+
+// update anchors the clock on the first call or when the device counter restarts
+func (c *sampleClock) update(index uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	if !c.anchored || index < c.lastIndex {
+		c.anchored = true
+		c.anchorIndex = index
+		c.anchorTime = time.Now()
+	}
+
+	c.lastIndex = index
+}
+
+// setSampleRate changes the rate of the device counter. The clock is anchored again on the next samples,
+// as changing the rate pauses the device stream.
+func (c *sampleClock) setSampleRate(sampleRate float64) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.sampleRate = sampleRate
+	c.anchored = false
+}
+
+// rate returns the rate of the device counter
+func (c *sampleClock) rate() float64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.sampleRate
+}
+
+// timeOf returns the wall clock time of the sample at index
+func (c *sampleClock) timeOf(index uint64) time.Time {
+	c.Lock()
+	defer c.Unlock()
+
+	if !c.anchored || c.sampleRate <= 0 {
+		return time.Now()
+	}
+
+	offset := (float64(index) - float64(c.anchorIndex)) / c.sampleRate
+	return c.anchorTime.Add(time.Duration(offset * float64(time.Second)))
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSampleClock(t *testing.T) {
+	c := &sampleClock{}
+	c.setSampleRate(1000)
+	c.update(0)
+
+	anchor := c.timeOf(0)
+	if d := c.timeOf(500).Sub(anchor); d != time.Millisecond*500 {
+		t.Errorf("500 samples at 1 kHz last %s, want 500ms", d)
+	}
+
+	// A rate change anchors the clock again on the next samples
+	c.update(1000)
+	c.setSampleRate(4000)
+	c.update(2000)
+
+	reanchor := c.timeOf(2000)
+	if reanchor.Before(anchor) {
+		t.Errorf("clock anchored back in time at %s, before %s", reanchor, anchor)
+	}
+	if d := c.timeOf(6000).Sub(reanchor); d != time.Second {
+		t.Errorf("4000 samples at 4 kHz last %s, want 1s", d)
+	}
+
+	// A device counter restart anchors it again too
+	c.update(10)
+	if c.timeOf(10).Before(reanchor) {
+		t.Error("clock not anchored again when the device counter restarted")
+	}
+}
+
+func TestTuneSampleRateUpdatesClock(t *testing.T) {
+	rs, cleanup := testServer(t)
+	defer cleanup()
+
+	s, f := provisionFake(t, rs, 48000)
+	f.feed(480)
+
+	c := s.DeviceConfig()
+	c.SampleRate = 96000
+	if err := s.TuneFrontend(&c); err != nil {
+		t.Fatal(err)
+	}
+
+	if rate := s.clock.rate(); rate != 96000 {
+		t.Errorf("clock at %v after the retune, want 96000", rate)
+	}
+
+	before := time.Now()
+	f.feed(960)
+	if ts := s.clock.timeOf(480); ts.Before(before) {
+		t.Errorf("first sample after the retune at %s, before it arrived at %s", ts, before)
+	}
+	if d := s.clock.timeOf(480 + 960).Sub(s.clock.timeOf(480)); d != time.Millisecond*10 {
+		t.Errorf("960 samples at 96 kHz last %s, want 10ms", d)
+	}
+}
@@ -0,0 +1,229 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/luigifreitas/radioserver/DSP"
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+const (
+	defaultScanFFTSize  = 1024
+	defaultScanAverages = 8
+	defaultSettleTime   = 0.01
+
+	// maxScanFFTSize bounds the spectrum size of a scan step
+	maxScanFFTSize = 1 << 16
+	// maxScanAverages and maxSettleTime bound the capture of a step, in spectra and seconds
+	maxScanAverages = 1024
+	maxSettleTime   = 1.0
+
+	// scanUsableBandwidth is the part of the sample rate kept from each step, leaving the filter roll off out
+	scanUsableBandwidth = 0.8
+
+	// defaultScanSNR is the threshold over the noise floor when a scan has no threshold
+	defaultScanSNR = 10
+)
+
+// tuneChannel tunes the first channel of the session to frequency, keeping the rest of its configuration
+func (s *Session) tuneChannel(frequency float64) error {
+	c := s.DeviceConfig()
+	if len(c.RXC) == 0 {
+		return fmt.Errorf("device has no receive channel")
+	}
+
+	channel := *c.RXC[0]
+	channel.CenterFrequency = float32(frequency)
+	c.RXC[0] = &channel
+
+	return s.TuneFrontend(&c)
+}
+
+// restoreTuning tunes the device back to c after a scan or a sweep, unless the session is gone
+func (s *Session) restoreTuning(c protocol.DeviceConfig) {
+	if !s.IsFullStopped() {
+		if err := s.TuneFrontend(&c); err != nil {
+			log.Error("Error restoring the tuning of %s: %s", s.ID, err)
+		}
+	}
+}
+
+// measureSpectrum tunes the first channel to frequency and returns the power spectrum of the samples received
+// after settle seconds, the frequency of its center and the time of the capture
+func (s *Session) measureSpectrum(ctx context.Context, frequency float64, size, averages int, settle float64) ([]float32, float64, time.Time, error) {
+	if err := s.tuneChannel(frequency); err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	config := s.frontend.GetDeviceConfig()
+	sampleRate := float64(config.SampleRate)
+
+	// The samples come straight from the frontend, tuned to the hardware frequency
+	lo := float64(config.RXC[0].CenterFrequency) * ppmFactor(s.PPM())
+
+	skip := int(settle * sampleRate)
+	n := size * averages
+	timeout := time.Duration(float64(skip+n)/sampleRate*float64(time.Second)) + captureTimeout
+
+	samples, err := s.captureInput(ctx, skip+n, timeout)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+	captured := time.Now().Add(-time.Duration(float64(n) / sampleRate * float64(time.Second)))
+
+	spectrum, err := DSP.PowerSpectrum(samples[skip:], size)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	return spectrum, lo, captured, nil
+}
+
+// scanSteps returns the center frequencies covering start to stop with a band of width every step
+func scanSteps(start, stop, width, step float64) []float64 {
+	var steps []float64
+	for c := start + width/2; c-width/2 < stop; c += step {
+		steps = append(steps, c)
+	}
+	return steps
+}
+
+// median returns the median of v
+func median(v []float32) float32 {
+	sorted := append([]float32(nil), v...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	return sorted[len(sorted)/2]
+}
+
+// scanStep measures the part of r around center and returns the peaks above threshold,
+// or above the noise floor by defaultScanSNR when threshold is zero
+func (s *Session) scanStep(ctx context.Context, r *protocol.ScanRequest, fr *protocol.FrequencyRange, center float64, size, averages int, settle float64) (*protocol.ScanResult, error) {
+	spectrum, lo, captured, err := s.measureSpectrum(ctx, center, size, averages, settle)
+	if err != nil {
+		return nil, err
+	}
+
+	sampleRate := float64(s.frontend.GetDeviceConfig().SampleRate)
+	resolution := sampleRate / float64(size)
+	half := sampleRate * scanUsableBandwidth / 2
+
+	start := math.Max(center-half, fr.Start)
+	stop := math.Min(center+half, fr.Stop)
+
+	// Bins of the spectrum within the step, relative to the tuned frequency
+	first := int(math.Ceil((start-lo)/resolution)) + size/2
+	last := int(math.Floor((stop-lo)/resolution)) + size/2
+	first = int(math.Max(float64(first), 0))
+	last = int(math.Min(float64(last), float64(size-1)))
+	if first > last {
+		return nil, fmt.Errorf("step at %v Hz has no bins", center)
+	}
+
+	window := spectrum[first : last+1]
+	noise := median(window)
+
+	threshold := r.Threshold
+	if threshold == 0 {
+		threshold = noise + defaultScanSNR
+	}
+
+	result := &protocol.ScanResult{
+		Timestamp:       uint64(captured.UnixNano()),
+		CenterFrequency: lo,
+		StartFrequency:  start,
+		StopFrequency:   stop,
+		NoiseFloor:      noise,
+	}
+
+	active := 0
+	for _, p := range DSP.FindPeaks(window, threshold) {
+		active += p.Last - p.First + 1
+		result.Peaks = append(result.Peaks, &protocol.ScanPeak{
+			Frequency: lo + float64(first+p.Bin-size/2)*resolution,
+			Power:     p.Power,
+			Bandwidth: float64(p.Last-p.First+1) * resolution,
+		})
+	}
+	result.Activity = float32(active) / float32(len(window))
+
+	return result, nil
+}
+
+// scan steps the session first channel across the ranges of r, sending the result of every step.
+// The channel is tuned back to its frequency when done.
+func (s *Session) scan(ctx context.Context, r *protocol.ScanRequest, send func(*protocol.ScanResult) error) error {
+	if len(r.Ranges) == 0 {
+		return fmt.Errorf("no frequency ranges")
+	}
+
+	for _, fr := range r.Ranges {
+		if fr == nil || fr.Stop <= fr.Start {
+			return fmt.Errorf("invalid frequency range")
+		}
+	}
+
+	size := int(r.FFTSize)
+	if size == 0 {
+		size = defaultScanFFTSize
+	}
+	if size > maxScanFFTSize {
+		return fmt.Errorf("fft size should be up to %d", maxScanFFTSize)
+	}
+
+	averages := int(r.Averages)
+	if averages == 0 {
+		averages = defaultScanAverages
+	}
+	if averages > maxScanAverages {
+		return fmt.Errorf("averages should be up to %d", maxScanAverages)
+	}
+
+	settle := r.SettleTime
+	if settle <= 0 {
+		settle = defaultSettleTime
+	}
+	if settle > maxSettleTime {
+		return fmt.Errorf("settle time should be up to %gs", maxSettleTime)
+	}
+
+	original := s.DeviceConfig()
+	defer s.restoreTuning(original)
+
+	width := float64(original.SampleRate) * scanUsableBandwidth
+	if width <= 0 {
+		return fmt.Errorf("device has no sample rate")
+	}
+
+	for pass := uint32(0); ; pass++ {
+		for i, fr := range r.Ranges {
+			steps := scanSteps(fr.Start, fr.Stop, width, width)
+			for j, center := range steps {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				result, err := s.scanStep(ctx, r, fr, center, size, averages, settle)
+				if err != nil {
+					return err
+				}
+				result.Pass = pass
+				result.PassDone = i == len(r.Ranges)-1 && j == len(steps)-1
+
+				if err := send(result); err != nil {
+					return err
+				}
+				s.KeepAlive()
+			}
+		}
+
+		if !r.Continuous {
+			return nil
+		}
+	}
+}
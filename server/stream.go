@@ -0,0 +1,183 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+// Stream runs a session over a single bidirectional stream.
+// The first command must carry the session token. When the client ends its side of the stream the session
+// stops streaming and is released for a later stream, it is destroyed when the stream fails.
+func (rs *RadioServer) Stream(server protocol.RadioServer_StreamServer) error {
+	first, err := server.Recv()
+	if err != nil {
+		return err
+	}
+
+	if first.Session == nil {
+		return fmt.Errorf("first command must have a session")
+	}
+
+	rs.sessionLock.Lock()
+	s := rs.sessions[first.Session.Token]
+	rs.sessionLock.Unlock()
+
+	if s == nil {
+		return fmt.Errorf("session doesn't exist")
+	}
+	if err := s.Attach(); err != nil {
+		return err
+	}
+
+	closed := false
+	defer func() {
+		if closed {
+			if s.IsStreaming() {
+				_ = s.StopStreaming()
+			}
+			s.Detach()
+			log.Info("Stream of %s closed by the client", s.ID)
+			return
+		}
+
+		s.FullStop()
+		rs.sessionLock.Lock()
+		delete(rs.sessions, first.Session.Token)
+		rs.sessionLock.Unlock()
+		log.Info("Stream of %s finished", s.ID)
+	}()
+
+	commands := make(chan *protocol.StreamCommand)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			cmd, err := server.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+
+			select {
+			case commands <- cmd:
+			case <-server.Context().Done():
+				return
+			}
+		}
+	}()
+
+	if err := server.Send(&protocol.StreamMessage{Ack: rs.handleStreamCommand(s, first)}); err != nil {
+		return err
+	}
+
+	var counters streamCounters
+	for {
+		select {
+		case cmd := <-commands:
+			if cmd.Type == protocol.CommandType_StartIQ || cmd.Type == protocol.CommandType_SetChain {
+				counters = streamCounters{}
+			}
+			if err := server.Send(&protocol.StreamMessage{Ack: rs.handleStreamCommand(s, cmd)}); err != nil {
+				return err
+			}
+		case item := <-s.IQQueue.Items():
+			buff := item.(*IQBuffer)
+			pb := makeIQData(buff)
+			counters.fill(buff, pb)
+			if err := server.Send(&protocol.StreamMessage{IQ: pb}); err != nil {
+				log.Error("Error sending samples to %s: %s", s.ID, err)
+				return err
+			}
+			s.KeepAlive()
+		case err := <-recvErr:
+			if err == io.EOF {
+				closed = true
+				return nil
+			}
+			return err
+		case <-server.Context().Done():
+			return server.Context().Err()
+		case <-s.Done():
+			return sendStreamStatus(server, protocol.StatusType_Error, "session expired")
+		case <-rs.ctx.Done():
+			log.Info("Server shutting down, closing stream of %s", s.ID)
+			return sendStreamStatus(server, protocol.StatusType_Error, "server shutting down")
+		}
+	}
+}
+
+// handleStreamCommand applies a command received on a session stream and builds its acknowledgement
+func (rs *RadioServer) handleStreamCommand(s *Session, cmd *protocol.StreamCommand) *protocol.StreamAck {
+	s.KeepAlive()
+
+	var err error
+	switch cmd.Type {
+	case protocol.CommandType_NoCommand:
+	case protocol.CommandType_StartIQ:
+		err = s.StartStreaming()
+	case protocol.CommandType_StopIQ:
+		err = s.StopStreaming()
+	case protocol.CommandType_TuneDevice:
+		if cmd.Config == nil {
+			err = fmt.Errorf("tune without config")
+			break
+		}
+		err = s.TuneFrontend(cmd.Config)
+	case protocol.CommandType_SetChain:
+		err = s.ConfigureChain(cmd.Chain)
+	case protocol.CommandType_SetGain:
+		if cmd.Gain < 0 || cmd.Gain > 1 {
+			err = fmt.Errorf("gain should be between 0 and 1")
+			break
+		}
+		err = s.TuneChannel(cmd.ChannelIndex, func(c *protocol.ChannelConfig) {
+			c.NormalizedGain = cmd.Gain
+		})
+	case protocol.CommandType_SetChannel:
+		if cmd.ChannelConfig == nil {
+			err = fmt.Errorf("set channel without channel config")
+			break
+		}
+		err = s.TuneChannel(cmd.ChannelIndex, func(c *protocol.ChannelConfig) {
+			*c = *cmd.ChannelConfig
+		})
+	default:
+		err = fmt.Errorf("invalid command %s", cmd.Type)
+	}
+
+	return streamAck(s, cmd, err)
+}
+
+// streamAck builds the acknowledgement of cmd, describing the state of s after it
+func streamAck(s *Session, cmd *protocol.StreamCommand, err error) *protocol.StreamAck {
+	config := s.DeviceConfig()
+	hardwareIndex, sampleIndex := s.Boundary()
+	ack := &protocol.StreamAck{
+		ID:            cmd.ID,
+		Type:          cmd.Type,
+		Status:        protocol.StatusType_OK,
+		Config:        &config,
+		SampleIndex:   sampleIndex,
+		HardwareIndex: hardwareIndex,
+		SinkRates:     s.SinkRates(),
+	}
+
+	if err != nil {
+		ack.Status = protocol.StatusType_Error
+		ack.Error = err.Error()
+	}
+
+	return ack
+}
+
+func sendStreamStatus(server protocol.RadioServer_StreamServer, status protocol.StatusType, message string) error {
+	return server.Send(&protocol.StreamMessage{
+		Status: &protocol.StreamStatus{
+			Timestamp: uint64(time.Now().UnixNano()),
+			Status:    status,
+			Message:   message,
+		},
+	})
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/luigifreitas/radioserver/DSP"
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+func TestStreamCommandAck(t *testing.T) {
+	rs, cleanup := testServer(t)
+	defer cleanup()
+
+	s, _ := provisionFake(t, rs, 48000)
+
+	tests := []struct {
+		name  string
+		cmd   protocol.StreamCommand
+		fails bool
+	}{
+		{"no command", protocol.StreamCommand{Type: protocol.CommandType_NoCommand}, false},
+		{"start", protocol.StreamCommand{Type: protocol.CommandType_StartIQ}, false},
+		{"start twice", protocol.StreamCommand{Type: protocol.CommandType_StartIQ}, true},
+		{"tune without config", protocol.StreamCommand{Type: protocol.CommandType_TuneDevice}, true},
+		{"tune", protocol.StreamCommand{Type: protocol.CommandType_TuneDevice, Config: testDevice(96000).Config}, false},
+		{"gain out of range", protocol.StreamCommand{Type: protocol.CommandType_SetGain, Gain: 2}, true},
+		{"gain", protocol.StreamCommand{Type: protocol.CommandType_SetGain, Gain: 0.5}, false},
+		{"channel without config", protocol.StreamCommand{Type: protocol.CommandType_SetChannel}, true},
+		{"invalid chain", protocol.StreamCommand{Type: protocol.CommandType_SetChain, Chain: []*protocol.BlockConfig{
+			{Type: protocol.BlockType_TranslatorBlock, Frequency: 1e6, Bandwidth: 10000},
+		}}, true},
+		{"stop", protocol.StreamCommand{Type: protocol.CommandType_StopIQ}, false},
+		{"stop twice", protocol.StreamCommand{Type: protocol.CommandType_StopIQ}, true},
+		{"invalid", protocol.StreamCommand{Type: protocol.CommandType(100)}, true},
+	}
+
+	for i, tt := range tests {
+		cmd := tt.cmd
+		cmd.ID = uint64(i)
+		ack := rs.handleStreamCommand(s, &cmd)
+
+		if ack.ID != cmd.ID || ack.Type != cmd.Type {
+			t.Errorf("%s: ack of command %d %s, want %d %s", tt.name, ack.ID, ack.Type, cmd.ID, cmd.Type)
+		}
+		if failed := ack.Status == protocol.StatusType_Error; failed != tt.fails || failed == (ack.Error == "") {
+			t.Errorf("%s: ack %s %q, want failure %v", tt.name, ack.Status, ack.Error, tt.fails)
+		}
+		if ack.Config == nil || len(ack.SinkRates) != 1 || ack.SinkRates[0] != ack.Config.SampleRate {
+			t.Errorf("%s: ack config %+v and sink rates %v don't match", tt.name, ack.Config, ack.SinkRates)
+		}
+	}
+
+	if c := s.DeviceConfig(); c.SampleRate != 96000 || c.RXC[0].NormalizedGain != 0.5 {
+		t.Errorf("device config %+v after the commands, want 96000 S/s and gain 0.5", c)
+	}
+}
+
+// TestStreamAckBoundary checks the ack of a retune points after the samples acquired before it,
+// even when they are still queued before the chain
+func TestStreamAckBoundary(t *testing.T) {
+	rs, cleanup := testServer(t)
+	defer cleanup()
+
+	s, f := provisionFake(t, rs, 48000)
+	rs.handleStreamCommand(s, &protocol.StreamCommand{Type: protocol.CommandType_StartIQ})
+
+	// Hold the chain output so the next blocks stay queued
+	release := make(chan bool)
+	outputs := make(chan DSP.Output, 10)
+	s.CG.SetOnOutput(func(o []DSP.Output, hardwareIndex uint64) {
+		<-release
+		outputs <- o[0]
+	})
+
+	f.feed(1000)
+	f.feed(1000)
+	f.feed(1000)
+
+	ack := rs.handleStreamCommand(s, &protocol.StreamCommand{
+		Type:   protocol.CommandType_TuneDevice,
+		Config: testDevice(96000).Config,
+	})
+	if ack.Status != protocol.StatusType_OK {
+		t.Fatal(ack.Error)
+	}
+	if ack.HardwareIndex != 3000 || ack.SampleIndex != 3000 {
+		t.Errorf("retune at sample %d (device %d), want 3000 (device 3000)", ack.SampleIndex, ack.HardwareIndex)
+	}
+
+	f.feed(1000)
+	close(release)
+
+	for i := 0; i < 3; i++ {
+		o := <-outputs
+		if o.Index >= ack.SampleIndex {
+			t.Errorf("sample %d acquired before the retune is after the boundary %d", o.Index, ack.SampleIndex)
+		}
+	}
+	if o := <-outputs; o.Index != ack.SampleIndex || o.SampleRate != 96000 {
+		t.Errorf("first output after the retune at %d and %v S/s, want %d and 96000", o.Index, o.SampleRate, ack.SampleIndex)
+	}
+}
+
+func TestBlockPolicy(t *testing.T) {
+	rs, cleanup := testServer(t)
+	defer cleanup()
+
+	// Block holds back the chain for the sender, the frontend keeps delivering
+	rs.SetOverflowPolicy(DSP.Block)
+	s, f := provisionFake(t, rs, 48000)
+	if err := s.StartStreaming(); err != nil {
+		t.Fatal(err)
+	}
+
+	f.feed(1000)
+	item := <-s.IQQueue.Items()
+	if b := item.(*IQBuffer); b.Index != 0 || b.Len() != 1000 {
+		t.Errorf("sent %d samples at %d, want 1000 at 0", b.Len(), b.Index)
+	}
+}
+
+func TestStreamCounters(t *testing.T) {
+	buffer := func(index, frontendDropped, squelched uint64) *IQBuffer {
+		return &IQBuffer{Output: DSP.Output{
+			Buffer:          DSP.Buffer{Kind: protocol.SampleKind_IQSamples, Complex: make([]complex64, 1000)},
+			Index:           index,
+			FrontendDropped: frontendDropped,
+			Squelched:       squelched,
+		}}
+	}
+
+	tests := []struct {
+		name                                      string
+		buffer                                    *IQBuffer
+		serverDropped, frontendDropped, squelched uint64
+	}{
+		{"first", buffer(0, 0, 0), 0, 0, 0},
+		{"contiguous", buffer(1000, 0, 0), 0, 0, 0},
+		{"squelched", buffer(2500, 0, 500), 0, 0, 500},
+		{"dropped by the frontend", buffer(3700, 200, 500), 0, 200, 0},
+		{"dropped by the session", buffer(5000, 200, 600), 200, 0, 100},
+	}
+
+	var counters streamCounters
+	for _, tt := range tests {
+		pb := makeIQData(tt.buffer)
+		counters.fill(tt.buffer, pb)
+		if pb.ServerDropped != tt.serverDropped || pb.FrontendDropped != tt.frontendDropped || pb.Squelched != tt.squelched {
+			t.Errorf("%s: %d dropped by the session, %d by the frontend and %d squelched, want %d, %d and %d", tt.name,
+				pb.ServerDropped, pb.FrontendDropped, pb.Squelched, tt.serverDropped, tt.frontendDropped, tt.squelched)
+		}
+	}
+}
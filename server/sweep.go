@@ -0,0 +1,200 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+const (
+	defaultSweepOverlap = 0.5
+
+	// maxSweepBins bounds the size of a sweep frame
+	maxSweepBins = 1 << 20
+
+	// dcGuardBins are the bins around the center of a hop left to its neighbours, as they hold the LO leakage
+	dcGuardBins = 2
+
+	// minHopWeight keeps the edges of the hops in the stitching, so every bin is covered
+	minHopWeight = 0.05
+)
+
+// sweepAccumulator stitches the spectra of the hops of a sweep on a single frequency grid.
+// Overlapping hops are averaged in linear power, weighting each bin by its distance to the center of its hop.
+type sweepAccumulator struct {
+	start      float64
+	resolution float64
+
+	power  []float64
+	weight []float64
+
+	// dcPower and dcWeight hold the bins close to a hop center, only used where no other hop covers them
+	dcPower  []float64
+	dcWeight []float64
+}
+
+func makeSweepAccumulator(start float64, bins int, resolution float64) *sweepAccumulator {
+	return &sweepAccumulator{
+		start:      start,
+		resolution: resolution,
+		power:      make([]float64, bins),
+		weight:     make([]float64, bins),
+		dcPower:    make([]float64, bins),
+		dcWeight:   make([]float64, bins),
+	}
+}
+
+// add stitches the bins within usable Hertz of lo of a spectrum centered on lo
+func (a *sweepAccumulator) add(spectrum []float32, lo, usable float64) {
+	half := len(spectrum) / 2
+
+	for i, v := range spectrum {
+		offset := float64(i-half) * a.resolution
+		if math.Abs(offset) > usable/2 {
+			continue
+		}
+
+		k := int(math.Floor((lo + offset - a.start) / a.resolution))
+		if k < 0 || k >= len(a.power) {
+			continue
+		}
+
+		w := math.Max(1-math.Abs(offset)/(usable/2), minHopWeight)
+		p := math.Pow(10, float64(v)/10) * w
+
+		if i-half >= -dcGuardBins && i-half <= dcGuardBins {
+			a.dcPower[k] += p
+			a.dcWeight[k] += w
+		} else {
+			a.power[k] += p
+			a.weight[k] += w
+		}
+	}
+}
+
+// frame returns the stitched spectrum in dB plus offset and clears the accumulator
+func (a *sweepAccumulator) frame(offset float32) []float32 {
+	out := make([]float32, len(a.power))
+
+	for k := range out {
+		p := 0.0
+		switch {
+		case a.weight[k] > 0:
+			p = a.power[k] / a.weight[k]
+		case a.dcWeight[k] > 0:
+			p = a.dcPower[k] / a.dcWeight[k]
+		}
+		out[k] = float32(10*math.Log10(p+1e-20)) + offset
+
+		a.power[k], a.weight[k] = 0, 0
+		a.dcPower[k], a.dcWeight[k] = 0, 0
+	}
+
+	return out
+}
+
+// sweepFFTSize returns the power of two FFT size giving bins of up to binWidth at sampleRate
+func sweepFFTSize(sampleRate, binWidth float64) int {
+	if binWidth <= 0 {
+		return defaultScanFFTSize
+	}
+
+	size := 16
+	for size < maxScanFFTSize && sampleRate/float64(size) > binWidth {
+		size *= 2
+	}
+	return size
+}
+
+// sweep retunes the session first channel in overlapping hops from the start to the stop frequency of r,
+// sending a stitched spectrum at the end of every sweep. The levels are in dB relative to full scale
+// plus the calibration offset of r. The channel is tuned back to its frequency when done.
+func (s *Session) sweep(ctx context.Context, r *protocol.SweepRequest, send func(*protocol.SweepFrame) error) error {
+	if r.StopFrequency <= r.StartFrequency {
+		return fmt.Errorf("invalid frequency range")
+	}
+
+	overlap := float64(r.Overlap)
+	if overlap == 0 {
+		overlap = defaultSweepOverlap
+	}
+	if overlap < 0 || overlap >= 1 {
+		return fmt.Errorf("overlap should be between 0 and 1")
+	}
+
+	averages := int(r.Averages)
+	if averages == 0 {
+		averages = defaultScanAverages
+	}
+	if averages > maxScanAverages {
+		return fmt.Errorf("averages should be up to %d", maxScanAverages)
+	}
+
+	settle := r.SettleTime
+	if settle <= 0 {
+		settle = defaultSettleTime
+	}
+	if settle > maxSettleTime {
+		return fmt.Errorf("settle time should be up to %gs", maxSettleTime)
+	}
+
+	original := s.DeviceConfig()
+	defer s.restoreTuning(original)
+
+	sampleRate := float64(original.SampleRate)
+	if sampleRate <= 0 {
+		return fmt.Errorf("device has no sample rate")
+	}
+
+	size := sweepFFTSize(sampleRate, r.BinWidth)
+	resolution := sampleRate / float64(size)
+	usable := sampleRate * scanUsableBandwidth
+
+	bins := int(math.Ceil((r.StopFrequency - r.StartFrequency) / resolution))
+	if bins > maxSweepBins {
+		return fmt.Errorf("sweep would have %d bins, up to %d are supported", bins, maxSweepBins)
+	}
+
+	hops := scanSteps(r.StartFrequency, r.StopFrequency, usable, usable*(1-overlap))
+	acc := makeSweepAccumulator(r.StartFrequency, bins, resolution)
+
+	for sweep := uint32(0); r.Sweeps == 0 || sweep < r.Sweeps; sweep++ {
+		var started time.Time
+
+		for i, center := range hops {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			spectrum, lo, captured, err := s.measureSpectrum(ctx, center, size, averages, settle)
+			if err != nil {
+				return err
+			}
+			if i == 0 {
+				started = captured
+			}
+
+			acc.add(spectrum, lo, usable)
+			// A sweep of many hops outlasts the session timeout before its first frame
+			s.KeepAlive()
+		}
+
+		err := send(&protocol.SweepFrame{
+			Timestamp:      uint64(started.UnixNano()),
+			Duration:       uint64(time.Since(started)),
+			StartFrequency: r.StartFrequency,
+			BinWidth:       resolution,
+			Power:          acc.frame(r.CalibrationOffset),
+			Sweep:          sweep,
+			Hops:           uint32(len(hops)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
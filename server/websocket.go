@@ -0,0 +1,267 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/luigifreitas/radioserver/protocol"
+	"golang.org/x/net/websocket"
+)
+
+// wsMaxCommandSize is the largest control message accepted from a WebSocket client
+const wsMaxCommandSize = 1 << 20
+
+// wsFrameHeaderSize is the size of the header of the binary sample frames
+const wsFrameHeaderSize = 32
+
+var wsMarshaler = jsonpb.Marshaler{}
+
+// serveWebSocket runs a session over a WebSocket connection, the browser counterpart of Stream.
+//
+// The client sends StreamCommand messages in their JSON mapping as text frames. ProvisionDevice opens the
+// device of the command in a session and DestroySession closes it. Any other command applies to the session
+// of the connection, or attaches the connection to the session of its token when it has none yet.
+// The server answers with StreamMessage JSON text frames, carrying the acknowledgements, the squelch events
+// and the status of the connection, and sends the chain outputs as binary frames, see encodeSampleFrame.
+// A session provisioned on the connection is destroyed when the connection ends, an attached one is left open.
+func (rs *RadioServer) serveWebSocket(ws *websocket.Conn) {
+	ws.MaxPayloadBytes = wsMaxCommandSize
+	defer ws.Close()
+
+	var c wsSession
+	var counters streamCounters
+
+	defer func() {
+		if c.s != nil {
+			log.Info("WebSocket of %s finished", c.s.ID)
+			c.release(rs)
+		}
+	}()
+
+	commands := make(chan *protocol.StreamCommand)
+	invalid := make(chan error)
+	recvErr := make(chan error, 1)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		for {
+			var data []byte
+			if err := websocket.Message.Receive(ws, &data); err != nil {
+				recvErr <- err
+				return
+			}
+
+			cmd := &protocol.StreamCommand{}
+			if err := jsonpb.Unmarshal(bytes.NewReader(data), cmd); err != nil {
+				select {
+				case invalid <- fmt.Errorf("invalid command: %s", err):
+				case <-done:
+					return
+				}
+				continue
+			}
+
+			select {
+			case commands <- cmd:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var frame []byte
+	for {
+		var items <-chan interface{}
+		var sessionDone <-chan struct{}
+		s := c.s
+		if s != nil {
+			items = s.IQQueue.Items()
+			sessionDone = s.Done()
+		}
+
+		select {
+		case cmd := <-commands:
+			ack := rs.handleWebSocketCommand(&c, cmd)
+			if c.s != s || cmd.Type == protocol.CommandType_StartIQ || cmd.Type == protocol.CommandType_SetChain {
+				counters = streamCounters{}
+			}
+			if err := sendWebSocketMessage(ws, &protocol.StreamMessage{Ack: ack}); err != nil {
+				return
+			}
+		case err := <-invalid:
+			if err := sendWebSocketStatus(ws, protocol.StatusType_Error, err.Error()); err != nil {
+				return
+			}
+		case item := <-items:
+			buff := item.(*IQBuffer)
+			pb := makeIQData(buff)
+			counters.fill(buff, pb)
+			s.KeepAlive()
+
+			if pb.Squelch != nil {
+				if err := sendWebSocketMessage(ws, &protocol.StreamMessage{IQ: pb}); err != nil {
+					return
+				}
+				continue
+			}
+
+			frame = encodeSampleFrame(frame, pb)
+			if err := websocket.Message.Send(ws, frame); err != nil {
+				log.Error("Error sending samples to %s: %s", s.ID, err)
+				return
+			}
+		case err := <-recvErr:
+			if err != io.EOF {
+				log.Warn("WebSocket from %s: %s", ws.Request().RemoteAddr, err)
+			}
+			return
+		case <-sessionDone:
+			// The connection stays open to provision another session
+			c.release(rs)
+			if err := sendWebSocketStatus(ws, protocol.StatusType_Error, "session expired"); err != nil {
+				return
+			}
+		case <-rs.ctx.Done():
+			_ = sendWebSocketStatus(ws, protocol.StatusType_Error, "server shutting down")
+			return
+		}
+	}
+}
+
+// wsSession is the session of a WebSocket connection. owned is set when the connection provisioned it.
+type wsSession struct {
+	s     *Session
+	owned bool
+}
+
+// release destroys the session when the connection owns it, otherwise only detaches from it
+func (c *wsSession) release(rs *RadioServer) {
+	if c.owned {
+		_ = rs.destroy(c.s.ID)
+	} else {
+		c.s.Detach()
+	}
+	c.s = nil
+	c.owned = false
+}
+
+// handleWebSocketCommand applies a command received on a WebSocket to the session of the connection,
+// returning its acknowledgement
+func (rs *RadioServer) handleWebSocketCommand(c *wsSession, cmd *protocol.StreamCommand) *protocol.StreamAck {
+	switch {
+	case cmd.Type == protocol.CommandType_ProvisionDevice:
+		if c.s != nil {
+			return streamAckStatus(cmd, fmt.Errorf("session %s is already open", c.s.ID))
+		}
+		if cmd.Device == nil {
+			return streamAckStatus(cmd, fmt.Errorf("provision without device"))
+		}
+
+		s, err := rs.provision(cmd.Device)
+		if err != nil {
+			return streamAckStatus(cmd, err)
+		}
+		// A new session has no stream yet
+		_ = s.Attach()
+		c.s = s
+		c.owned = true
+
+		ack := streamAck(s, cmd, nil)
+		ack.Session = &protocol.Session{Token: s.ID}
+		return ack
+	case cmd.Type == protocol.CommandType_DestroySession:
+		if c.s == nil {
+			return streamAckStatus(cmd, fmt.Errorf("no session"))
+		}
+		err := rs.destroy(c.s.ID)
+		c.s = nil
+		c.owned = false
+		return streamAckStatus(cmd, err)
+	case c.s == nil && cmd.Session != nil:
+		rs.sessionLock.Lock()
+		s := rs.sessions[cmd.Session.Token]
+		rs.sessionLock.Unlock()
+
+		if s == nil {
+			return streamAckStatus(cmd, fmt.Errorf("session doesn't exist"))
+		}
+		// Two streams would split the samples of the session between them
+		if err := s.Attach(); err != nil {
+			return streamAckStatus(cmd, err)
+		}
+		c.s = s
+	case c.s == nil:
+		return streamAckStatus(cmd, fmt.Errorf("no session, provision a device first"))
+	}
+
+	ack := rs.handleStreamCommand(c.s, cmd)
+	ack.Session = &protocol.Session{Token: c.s.ID}
+	return ack
+}
+
+// streamAckStatus builds the acknowledgement of a command without a session to describe
+func streamAckStatus(cmd *protocol.StreamCommand, err error) *protocol.StreamAck {
+	ack := &protocol.StreamAck{
+		ID:     cmd.ID,
+		Type:   cmd.Type,
+		Status: protocol.StatusType_OK,
+	}
+
+	if err != nil {
+		ack.Status = protocol.StatusType_Error
+		ack.Error = err.Error()
+	}
+
+	return ack
+}
+
+// encodeSampleFrame writes the samples of pb as a binary frame into buff, growing it when needed.
+// The little endian header holds the sample kind (uint8), a reserved byte, the sink (uint16), the sample rate
+// (float32), the index of the first sample (uint64), its timestamp in ns (uint64) and the samples lost before
+// it (uint64). The float32 samples follow, interleaved I and Q for the IQ samples.
+func encodeSampleFrame(buff []byte, pb *protocol.IQData) []byte {
+	size := wsFrameHeaderSize + len(pb.Samples)*4
+	if cap(buff) < size {
+		buff = make([]byte, size)
+	}
+	buff = buff[:size]
+
+	buff[0] = byte(pb.Kind)
+	buff[1] = 0
+	binary.LittleEndian.PutUint16(buff[2:], uint16(pb.Sink))
+	binary.LittleEndian.PutUint32(buff[4:], math.Float32bits(pb.SampleRate))
+	binary.LittleEndian.PutUint64(buff[8:], pb.SampleIndex)
+	binary.LittleEndian.PutUint64(buff[16:], pb.Timestamp)
+	binary.LittleEndian.PutUint64(buff[24:], pb.FrontendDropped+pb.ServerDropped)
+
+	for i, v := range pb.Samples {
+		binary.LittleEndian.PutUint32(buff[wsFrameHeaderSize+i*4:], math.Float32bits(v))
+	}
+
+	return buff
+}
+
+func sendWebSocketMessage(ws *websocket.Conn, msg proto.Message) error {
+	data, err := wsMarshaler.MarshalToString(msg)
+	if err != nil {
+		return err
+	}
+	return websocket.Message.Send(ws, data)
+}
+
+func sendWebSocketStatus(ws *websocket.Conn, status protocol.StatusType, message string) error {
+	return sendWebSocketMessage(ws, &protocol.StreamMessage{
+		Status: &protocol.StreamStatus{
+			Timestamp: uint64(time.Now().UnixNano()),
+			Status:    status,
+			Message:   message,
+		},
+	})
+}
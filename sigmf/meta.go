@@ -0,0 +1,73 @@
+package sigmf
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+)
+
+const Version = "0.0.2"
+
+const (
+	DataExtension = ".sigmf-data"
+	MetaExtension = ".sigmf-meta"
+)
+
+type Global struct {
+	Datatype    string  `json:"core:datatype"`
+	SampleRate  float64 `json:"core:sample_rate,omitempty"`
+	Version     string  `json:"core:version"`
+	Description string  `json:"core:description,omitempty"`
+	Recorder    string  `json:"core:recorder,omitempty"`
+	Hardware    string  `json:"core:hw,omitempty"`
+}
+
+type Capture struct {
+	SampleStart uint64  `json:"core:sample_start"`
+	Frequency   float64 `json:"core:frequency"`
+	DateTime    string  `json:"core:datetime,omitempty"`
+	Gain        float32 `json:"radioserver:gain"`
+	Antenna     string  `json:"radioserver:antenna,omitempty"`
+}
+
+type Annotation struct {
+	SampleStart uint64 `json:"core:sample_start"`
+	SampleCount uint64 `json:"core:sample_count"`
+	Comment     string `json:"core:comment,omitempty"`
+}
+
+type Meta struct {
+	Global      Global       `json:"global"`
+	Captures    []Capture    `json:"captures"`
+	Annotations []Annotation `json:"annotations"`
+}
+
+// FormatDateTime formats t as a SigMF core:datetime string
+func FormatDateTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// ReadMeta loads the metadata file at path
+func ReadMeta(path string) (*Meta, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Meta
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// WriteMeta saves m to path
+func WriteMeta(path string, m *Meta) error {
+	data, err := json.MarshalIndent(m, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0644)
+}
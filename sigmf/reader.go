@@ -0,0 +1,88 @@
+package sigmf
+
+import (
+	"io"
+	"os"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+// Reader reads back a SigMF recording stored as a data / meta file pair
+type Reader struct {
+	Meta *Meta
+
+	format  protocol.SampleFormat
+	file    *os.File
+	samples uint64
+	buff    []byte
+}
+
+// Open opens basePath.sigmf-meta and basePath.sigmf-data
+func Open(basePath string) (*Reader, error) {
+	m, err := ReadMeta(basePath + MetaExtension)
+	if err != nil {
+		return nil, err
+	}
+
+	format, err := protocol.SampleFormatFromSigMF(m.Global.Datatype)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(basePath + DataExtension)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return &Reader{
+		Meta:    m,
+		format:  format,
+		file:    f,
+		samples: uint64(st.Size()) / uint64(format.SampleSize()),
+	}, nil
+}
+
+// Format returns the sample format of the data file
+func (r *Reader) Format() protocol.SampleFormat {
+	return r.format
+}
+
+// Samples returns the number of samples in the data file
+func (r *Reader) Samples() uint64 {
+	return r.samples
+}
+
+// ReadSamples reads up to n samples starting at sample offset.
+// It returns io.EOF when offset is past the end of the data file.
+func (r *Reader) ReadSamples(offset uint64, n int) ([]complex64, error) {
+	if offset >= r.samples {
+		return nil, io.EOF
+	}
+
+	if remaining := r.samples - offset; uint64(n) > remaining {
+		n = int(remaining)
+	}
+
+	size := n * r.format.SampleSize()
+	if cap(r.buff) < size {
+		r.buff = make([]byte, size)
+	}
+	r.buff = r.buff[:size]
+
+	read, err := r.file.ReadAt(r.buff, int64(offset)*int64(r.format.SampleSize()))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return r.format.Decode(nil, r.buff[:read]), nil
+}
+
+func (r *Reader) Close() error {
+	return r.file.Close()
+}
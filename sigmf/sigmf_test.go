@@ -0,0 +1,126 @@
+package sigmf
+
+import (
+	"io"
+	"io/ioutil"
+	"math/cmplx"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+func TestRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sigmf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	samples := []complex64{0.5 - 0.5i, -0.25 + 0.75i, -1, 1i, 0.1 - 0.9i}
+
+	tests := []struct {
+		format    protocol.SampleFormat
+		tolerance float64
+	}{
+		{protocol.SampleFormat_CF32, 0},
+		{protocol.SampleFormat_CS16, 2.0 / 32767},
+		{protocol.SampleFormat_CS8, 2.0 / 127},
+		{protocol.SampleFormat_CU8, 2.0 / 127.5},
+	}
+
+	for _, tt := range tests {
+		path := filepath.Join(dir, tt.format.String())
+
+		w, err := Create(path, tt.format, Global{SampleRate: 48000, Description: "test"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.AddCapture(Capture{Frequency: 100e6}); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Write(samples[:2]); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.AddCapture(Capture{Frequency: 101e6}); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Write(samples[2:]); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.Format() != tt.format || r.Samples() != uint64(len(samples)) {
+			t.Errorf("%s: read back %s with %d samples", tt.format, r.Format(), r.Samples())
+		}
+		if r.Meta.Global.SampleRate != 48000 || r.Meta.Global.Datatype != tt.format.SigMFDatatype() {
+			t.Errorf("%s: read back global %+v", tt.format, r.Meta.Global)
+		}
+		if len(r.Meta.Captures) != 2 || r.Meta.Captures[1].SampleStart != 2 || r.Meta.Captures[1].Frequency != 101e6 {
+			t.Errorf("%s: read back captures %+v", tt.format, r.Meta.Captures)
+		}
+
+		read, err := r.ReadSamples(1, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(read) != len(samples)-1 {
+			t.Errorf("%s: read %d samples from 1, want %d", tt.format, len(read), len(samples)-1)
+		}
+		for i := range read {
+			if cmplx.Abs(complex128(read[i]-samples[i+1])) > tt.tolerance {
+				t.Errorf("%s: sample %d read as %v, want %v", tt.format, i+1, read[i], samples[i+1])
+			}
+		}
+
+		if _, err := r.ReadSamples(uint64(len(samples)), 1); err != io.EOF {
+			t.Errorf("%s: read past the end returned %v, want EOF", tt.format, err)
+		}
+		_ = r.Close()
+	}
+}
+
+func TestOpenInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sigmf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	unsupported := filepath.Join(dir, "unsupported")
+	if err := WriteMeta(unsupported+MetaExtension, &Meta{Global: Global{Datatype: "ri16_be"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(unsupported+DataExtension, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	noData := filepath.Join(dir, "nodata")
+	if err := WriteMeta(noData+MetaExtension, &Meta{Global: Global{Datatype: "cf32_le"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		basePath string
+	}{
+		{"missing", filepath.Join(dir, "missing")},
+		{"unsupported datatype", unsupported},
+		{"no data file", noData},
+	}
+
+	for _, tt := range tests {
+		if r, err := Open(tt.basePath); err == nil {
+			_ = r.Close()
+			t.Errorf("%s: recording opened", tt.name)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package sigmf
+
+import (
+	"bufio"
+	"os"
+	"sync"
+
+	"github.com/luigifreitas/radioserver/protocol"
+)
+
+// Writer stores a SigMF recording as a data / meta file pair sharing the same base path
+type Writer struct {
+	sync.Mutex
+
+	basePath string
+	format   protocol.SampleFormat
+	file     *os.File
+	out      *bufio.Writer
+	buff     []byte
+	meta     Meta
+	samples  uint64
+}
+
+// Create creates basePath.sigmf-data and basePath.sigmf-meta.
+// The datatype and version of global are filled in from format.
+func Create(basePath string, format protocol.SampleFormat, global Global) (*Writer, error) {
+	f, err := os.Create(basePath + DataExtension)
+	if err != nil {
+		return nil, err
+	}
+
+	global.Datatype = format.SigMFDatatype()
+	global.Version = Version
+
+	w := &Writer{
+		basePath: basePath,
+		format:   format,
+		file:     f,
+		out:      bufio.NewWriter(f),
+		meta: Meta{
+			Global:      global,
+			Captures:    []Capture{},
+			Annotations: []Annotation{},
+		},
+	}
+
+	if err := w.writeMeta(); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Write appends samples to the data file
+func (w *Writer) Write(samples []complex64) error {
+	w.Lock()
+	defer w.Unlock()
+
+	w.buff = w.format.Encode(w.buff, samples)
+	if _, err := w.out.Write(w.buff); err != nil {
+		return err
+	}
+
+	w.samples += uint64(len(samples))
+	return nil
+}
+
+// AddCapture starts a new capture segment at the current sample and updates the meta file
+func (w *Writer) AddCapture(c Capture) error {
+	w.Lock()
+	defer w.Unlock()
+
+	c.SampleStart = w.samples
+	n := len(w.meta.Captures)
+	if n > 0 && w.meta.Captures[n-1].SampleStart == c.SampleStart {
+		// No samples were written with the previous settings, so just replace it
+		w.meta.Captures[n-1] = c
+	} else {
+		w.meta.Captures = append(w.meta.Captures, c)
+	}
+
+	return w.writeMeta()
+}
+
+// Samples returns the number of samples written so far
+func (w *Writer) Samples() uint64 {
+	w.Lock()
+	defer w.Unlock()
+	return w.samples
+}
+
+// Close flushes the data file and writes the final meta file
+func (w *Writer) Close() error {
+	w.Lock()
+	defer w.Unlock()
+
+	if err := w.out.Flush(); err != nil {
+		_ = w.file.Close()
+		return err
+	}
+
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+
+	return w.writeMeta()
+}
+
+func (w *Writer) writeMeta() error {
+	return WriteMeta(w.basePath+MetaExtension, &w.meta)
+}
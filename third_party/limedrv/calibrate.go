@@ -0,0 +1,18 @@
+package limedrv
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/myriadrf/limedrv/limewrap"
+)
+
+// Calibrate runs the LimeSuite calibration of the specified channel for bandwidth in Hertz
+func (d *LMSDevice) Calibrate(channelNumber int, isRX bool, bandwidth float64) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if limewrap.LMS_Calibrate(d.dev, !isRX, int64(channelNumber), bandwidth, 0) != 0 {
+		return fmt.Errorf("failed to calibrate %s at %s: %s", d.DeviceInfo.DeviceName, d.DeviceInfo.Media, limewrap.LMS_GetLastErrorMessage())
+	}
+	return nil
+}
@@ -0,0 +1,4 @@
+package limewrap
+
+const LmsChTx = true
+const LmsChRx = false
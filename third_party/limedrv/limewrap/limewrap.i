@@ -0,0 +1,15 @@
+/* limewrap.i */
+%feature("autodoc", "0");
+%module limewrap
+%{
+#include <lime/LimeSuite.h>
+%}
+
+%insert(cgo_comment_typedefs) %{
+#cgo LDFLAGS: -lLimeSuite
+%}
+
+#define _DOXYGEN_ONLY_
+%include "stdint.i"
+
+%include "/usr/include/lime/LimeSuite.h"
\ No newline at end of file